@@ -0,0 +1,14 @@
+package main
+
+import (
+	"context"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/api"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/feed"
+)
+
+// runAPI запускает HTTP API и блокируется до отмены ctx. Адрес, размеры страниц
+// и токен административного API задаются в секции api конфигурации
+func runAPI(ctx context.Context, a *app, args []string) error {
+	return api.NewServer(a.repository, feed.NewGenerator(a.repository, a.config.Feed), a.logger, a.config.API).Run(ctx)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/config"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/db"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/parser"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/parser/telegram"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/jobs"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/service"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap"
+)
+
+// collector - операции сервиса сбора вакансий, используемые командами
+type collector interface {
+	CollectJobs(ctx context.Context) ([]model.RunReport, error)
+	CollectJobsFrom(ctx context.Context, parserName string) (model.RunReport, error)
+	RevisitJobs(ctx context.Context) error
+	RevisitJobsFrom(ctx context.Context, parserName string) error
+}
+
+// app объединяет зависимости, общие для всех команд
+type app struct {
+	config     config.Config
+	logger     *zap.Logger
+	database   *pgxpool.Pool
+	repository repository.JobsRepository
+	parsers    []parser.Parser
+	service    collector
+	out        io.Writer
+	// stopSignals возвращает обработку SIGINT/SIGTERM по умолчанию
+	stopSignals context.CancelFunc
+}
+
+// newApp подключается к базе данных и собирает репозиторий, парсеры и сервис
+func newApp(ctx context.Context, cfg config.Config, logger *zap.Logger, stopSignals context.CancelFunc) (*app, error) {
+	database, err := db.InitDB(ctx, cfg.DB.ConnString(), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	repository := jobs.NewRepository(database, logger, cfg.Jobs)
+
+	telegramParser := telegram.NewTelegramParser(repository, logger, cfg.Telegram)
+
+	parsers := []parser.Parser{telegramParser}
+
+	return &app{
+		config:      cfg,
+		logger:      logger,
+		database:    database,
+		repository:  repository,
+		parsers:     parsers,
+		service:     service.NewService(repository, parsers, logger),
+		out:         os.Stdout,
+		stopSignals: stopSignals,
+	}, nil
+}
+
+// Close закрывает соединение с базой данных
+func (a *app) Close() {
+	a.database.Close()
+}
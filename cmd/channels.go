@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// runChannels выводит, добавляет, удаляет, перематывает, включает и отключает Telegram-каналы
+// и проверяет их здоровье
+func runChannels(ctx context.Context, a *app, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("channels: укажите действие: list, add, remove, rewind, enable, disable, health или check")
+	}
+
+	action, tags := args[0], args[1:]
+
+	switch action {
+	case "list":
+		return listChannels(ctx, a)
+	case "rewind":
+		return rewindChannel(ctx, a, tags)
+	case "health":
+		return printChannelHealth(ctx, a)
+	case "check":
+		return checkChannelHealth(ctx, a)
+	case "add", "remove", "enable", "disable":
+		if len(tags) == 0 {
+			return fmt.Errorf("channels %s: укажите хотя бы один тег канала", action)
+		}
+	default:
+		return fmt.Errorf("channels: неизвестное действие %q", action)
+	}
+
+	for _, tag := range tags {
+		if action == "enable" || action == "disable" {
+			found, err := a.repository.SetChannelEnabled(ctx, tag, action == "enable")
+			if err != nil {
+				return err
+			}
+			switch {
+			case !found:
+				fmt.Fprintf(a.out, "Канал %s не найден\n", tag)
+			case action == "enable":
+				fmt.Fprintf(a.out, "Канал %s включен\n", tag)
+			default:
+				fmt.Fprintf(a.out, "Канал %s отключен\n", tag)
+			}
+			continue
+		}
+
+		if action == "add" {
+			added, err := a.repository.AddChannel(ctx, tag)
+			if err != nil {
+				return err
+			}
+			if added {
+				fmt.Fprintf(a.out, "Канал %s добавлен\n", tag)
+			} else {
+				fmt.Fprintf(a.out, "Канал %s уже есть в БД\n", tag)
+			}
+			continue
+		}
+
+		removed, err := a.repository.RemoveChannel(ctx, tag)
+		if err != nil {
+			return err
+		}
+		if removed {
+			fmt.Fprintf(a.out, "Канал %s удален\n", tag)
+		} else {
+			fmt.Fprintf(a.out, "Канал %s не найден\n", tag)
+		}
+	}
+
+	return nil
+}
+
+// listChannels выводит таблицу включенных каналов с прогрессом парсинга
+func listChannels(ctx context.Context, a *app) error {
+	channels, err := a.repository.GetTelegramChannels(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TAG\tLAST POST\tPOSTS PARSED\tLAST PARSED")
+
+	for _, channel := range channels {
+		lastPost := "-"
+		if channel.LastPostID != nil {
+			lastPost = fmt.Sprint(*channel.LastPostID)
+		}
+
+		lastParsed := "-"
+		if channel.DateLastParsed != nil {
+			lastParsed = channel.DateLastParsed.Format("2006-01-02 15:04")
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", channel.Tag, lastPost, channel.PostsParsed, lastParsed)
+	}
+
+	return w.Flush()
+}
+
+// rewindChannel откатывает last_post_id канала, чтобы следующий сбор заново прошел более новые посты
+func rewindChannel(ctx context.Context, a *app, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("channels rewind: укажите тег канала и ID поста")
+	}
+
+	postID, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil || postID < 0 {
+		return fmt.Errorf("channels rewind: некорректный ID поста %q", args[1])
+	}
+
+	found, err := a.repository.RewindChannel(ctx, args[0], postID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		fmt.Fprintf(a.out, "Канал %s не найден\n", args[0])
+		return nil
+	}
+
+	fmt.Fprintf(a.out, "Канал %s будет собран заново начиная с поста %d\n", args[0], postID+1)
+	return nil
+}
+
+// printChannelHealth выводит показатели здоровья всех каналов, включая отключенные
+func printChannelHealth(ctx context.Context, a *app) error {
+	channels, err := a.repository.GetChannelHealth(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TAG\tENABLED\tFAILURES\tDAYS SINCE POST\tRECENT POSTS\tSTOP WORDS\tNO TECHNOLOGY\tISSUES")
+
+	for _, h := range channels {
+		enabled := "yes"
+		if !h.Enabled {
+			enabled = "no (" + h.DisabledReason + ")"
+		}
+
+		days := "-"
+		if d := h.DaysSinceLastPost(now); d >= 0 {
+			days = fmt.Sprint(d)
+		}
+
+		issues := "-"
+		if len(h.Issues) > 0 {
+			issues = strings.Join(h.Issues, ", ")
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%d\t%.0f%%\t%.0f%%\t%s\n",
+			h.Tag, enabled, h.ConsecutiveFailures, days, h.RecentPosts,
+			h.StopWordsShare*100, h.UnclassifiedShare*100, issues)
+	}
+
+	return w.Flush()
+}
+
+// checkChannelHealth проверяет здоровье каналов и отключает проблемные
+func checkChannelHealth(ctx context.Context, a *app) error {
+	disabled, err := a.repository.CheckChannelHealth(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Отключено каналов: %d\n", disabled)
+	return nil
+}
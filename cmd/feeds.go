@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/feed"
+)
+
+// runFeeds записывает статические ленты RSS, Atom и JSON Feed: общие, по технологиям и по каналам.
+// Каталог берется из feed.dir конфигурации или флага -dir
+func runFeeds(ctx context.Context, a *app, args []string) error {
+	flags := flag.NewFlagSet("feeds", flag.ContinueOnError)
+	dir := flags.String("dir", a.config.Feed.Dir, "каталог для лент")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	written, err := feed.NewGenerator(a.repository, a.config.Feed).WriteFiles(ctx, *dir)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Записано лент: %d в %s\n", written, *dir)
+	return nil
+}
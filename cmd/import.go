@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/db"
+)
+
+// runImport загружает каналы, технологии, стоп-слова или хэштеги технологий из файлов, заданных глобальными флагами
+func runImport(ctx context.Context, a *app, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("import: укажите что импортировать: channels, technologies, stop-words, tag-aliases или all")
+	}
+
+	paths := a.config.Data
+
+	switch args[0] {
+	case "channels":
+		return db.ImportTelegramChannels(ctx, a.repository, paths.TelegramChannels, a.logger)
+	case "technologies":
+		return db.ImportTechnologies(ctx, a.repository, paths.Technologies, a.logger)
+	case "stop-words":
+		return db.ImportStopWords(ctx, a.repository, paths.StopWords, a.logger)
+	case "tag-aliases":
+		return db.ImportTagAliases(ctx, a.repository, paths.TagAliases, a.logger)
+	case "all":
+		return db.PopulateDatabase(ctx, a.repository, paths, a.logger)
+	default:
+		return fmt.Errorf("import: неизвестный тип данных %q", args[0])
+	}
+}
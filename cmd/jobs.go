@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// runJobs переводит устаревшие вакансии в expired или вручную меняет статус вакансии
+func runJobs(ctx context.Context, a *app, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("jobs: укажите действие: expire или status")
+	}
+
+	switch args[0] {
+	case "expire":
+		return expireJobs(ctx, a)
+	case "status":
+		return setJobStatus(ctx, a, args[1:])
+	default:
+		return fmt.Errorf("jobs: неизвестное действие %q", args[0])
+	}
+}
+
+// expireJobs переводит устаревшие вакансии в expired и пересчитывает счетчики технологий
+func expireJobs(ctx context.Context, a *app) error {
+	expired, err := a.repository.ExpireJobs(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Устаревших вакансий: %d\n", expired)
+	if expired == 0 {
+		return nil
+	}
+
+	return runRecount(ctx, a, nil)
+}
+
+// setJobStatus вручную меняет статус вакансии по ID и пересчитывает счетчики технологий
+func setJobStatus(ctx context.Context, a *app, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("jobs status: укажите ID вакансии и статус")
+	}
+
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil || id <= 0 {
+		return fmt.Errorf("jobs status: некорректный ID вакансии %q", args[0])
+	}
+
+	found, err := a.repository.SetJobStatus(ctx, id, args[1])
+	if err != nil {
+		return err
+	}
+	if !found {
+		fmt.Fprintf(a.out, "Вакансия %d не найдена\n", id)
+		return nil
+	}
+
+	fmt.Fprintf(a.out, "Статус вакансии %d: %s\n", id, args[1])
+	return runRecount(ctx, a, nil)
+}
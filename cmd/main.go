@@ -2,18 +2,99 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
 
-	"github.com/zalhonan/remotejobs-web-scraper/internal/db"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/config"
 	"github.com/zalhonan/remotejobs-web-scraper/internal/logger"
-	"github.com/zalhonan/remotejobs-web-scraper/internal/parser"
-	"github.com/zalhonan/remotejobs-web-scraper/internal/parser/telegram"
-	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/jobs"
-	"github.com/zalhonan/remotejobs-web-scraper/internal/service"
 	"go.uber.org/zap"
 )
 
+// command - подкоманда CLI
+type command struct {
+	args        string
+	description string
+	run         func(ctx context.Context, a *app, args []string) error
+}
+
+var commands = map[string]command{
+	"run":        {"", "однократный импорт данных в БД, сбор вакансий и пересчет технологий (по умолчанию)", runAll},
+	"scrape":     {"[-no-recount]", "собрать вакансии всеми парсерами", runScrape},
+	"serve":      {"", "собирать вакансии по расписанию до остановки", runServe},
+	"revisit":    {"", "заново собрать недавние посты, чтобы найти правки, закрытые и удаленные вакансии", runRevisit},
+	"import":     {"channels|technologies|stop-words|tag-aliases|all", "загрузить данные из файлов в БД поверх правок административного API", runImport},
+	"recount":    {"", "пересчитать количество вакансий по технологиям", runRecount},
+	"reclassify": {"", "заново определить технологии и стоп-слова сохраненных вакансий", runReclassify},
+	"backfill":   {"", "заново извлечь зарплату, формат работы, грейд, занятость, контакты и хэштеги вакансий", runBackfill},
+	"channels":   {"list|health|check|add|remove|enable|disable <tag>...|rewind <tag> <post_id>", "управление Telegram-каналами и проверка их здоровья", runChannels},
+	"jobs":       {"expire|status <id> <status>", "перевести устаревшие вакансии в expired или вручную сменить статус вакансии", runJobs},
+	"stats":      {"", "вывести статистику по вакансиям", runStats},
+	"search":     {"[-limit N] [-technology T] <запрос>", "найти активные вакансии полнотекстовым поиском", runSearch},
+	"tags":       {"[-limit N]", "вывести хэштеги вакансий и связанные технологии", runTags},
+	"runs":       {"[-limit N]", "вывести историю запусков парсеров", runRuns},
+	"feeds":      {"[-dir DIR]", "записать статические ленты RSS, Atom и JSON Feed", runFeeds},
+	"site":       {"[-dir DIR] [-html]", "выгрузить sitemap.xml и статический сайт с вакансиями", runSite},
+	"api":        {"", "запустить HTTP API для чтения вакансий и административный API (при заданном api.admin_token)", runAPI},
+}
+
+// commandOrder задает порядок команд в справке
+var commandOrder = []string{"run", "scrape", "serve", "revisit", "import", "recount", "reclassify", "backfill", "channels", "jobs", "search", "stats", "tags", "runs", "feeds", "site", "api"}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Использование: %s [флаги] <команда> [аргументы]\n\nКоманды:\n", os.Args[0])
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, name := range commandOrder {
+		fmt.Fprintf(w, "  %s %s\t%s\n", name, commands[name].args, commands[name].description)
+	}
+	w.Flush()
+
+	fmt.Fprintf(out, "\nФлаги:\n")
+	flag.PrintDefaults()
+}
+
 func main() {
-	logger, err := logger.InitLogger()
+	flags := config.RegisterFlags(flag.CommandLine)
+	flag.Usage = usage
+	flag.Parse()
+
+	name := "run"
+	args := flag.Args()
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+
+	if name == "help" {
+		usage()
+		return
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Неизвестная команда: %s\n\n", name)
+		usage()
+		os.Exit(2)
+	}
+
+	// Конфигурация: значения по умолчанию, файл, переменные окружения, флаги
+	cfg, err := config.Load(flags.ConfigPath())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Не удалось загрузить конфигурацию: %v\n", err)
+		os.Exit(2)
+	}
+	flags.Apply(&cfg)
+
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Некорректная конфигурация:\n%v\n", err)
+		os.Exit(2)
+	}
+
+	logger, err := logger.NewLogger(cfg.Log)
 	if err != nil {
 		panic("Cannot init logger: " + err.Error())
 	}
@@ -21,41 +102,26 @@ func main() {
 
 	logger.Info("Starting remote jobs scraper",
 		zap.String("version", "1.0.0"),
+		zap.String("command", name),
 	)
 
-	ctx := context.Background()
+	// Контекст отменяется по SIGINT/SIGTERM; повторный сигнал завершает процесс сразу
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	// Инициализация соединения с базой данных
-	database, err := db.InitDB(ctx, logger)
+	a, err := newApp(ctx, cfg, logger, stop)
 	if err != nil {
 		logger.Fatal("Не удалось инициализировать базу данных", zap.Error(err))
 	}
-	defer database.Close()
+	defer a.Close()
 
-	repository := jobs.NewRepository(database, logger, ctx)
-
-	// Загрузка необходимых данных в базу данных
-	if err := db.PopulateDatabase(ctx, repository, logger); err != nil {
-		logger.Error("Ошибка при загрузке данных в базу", zap.Error(err))
-	}
-
-	telegramParser := telegram.NewTelegramParser(repository, logger, ctx)
-
-	parsers := []parser.Parser{telegramParser}
-
-	service := service.NewService(repository, parsers, logger, ctx)
-
-	if err := service.CollectJobs(); err != nil {
-		logger.Error("Ошибка сбора вакансий",
+	if err := cmd.run(ctx, a, args); err != nil {
+		logger.Error("Ошибка выполнения команды",
+			zap.String("command", name),
 			zap.Error(err),
 		)
-	}
-
-	logger.Info("Вакансии успешно собраны")
-
-	if err := repository.UpdateTechnologiesCount(); err != nil {
-		logger.Error("Ошибка обновления count в technologies", zap.Error(err))
-	} else {
-		logger.Info("Таблица technologies обновлена: count пересчитан")
+		a.Close()
+		logger.Sync()
+		os.Exit(1)
 	}
 }
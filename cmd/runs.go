@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// runRuns выводит историю запусков парсеров
+func runRuns(ctx context.Context, a *app, args []string) error {
+	flags := flag.NewFlagSet("runs", flag.ContinueOnError)
+	limit := flags.Int("limit", 20, "сколько последних запусков вывести (0 - все)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	runs, err := a.repository.GetScrapeRuns(ctx, *limit)
+	if err != nil {
+		return err
+	}
+
+	return printRunReports(a.out, runs)
+}
+
+// printRunReports выводит таблицу отчетов о запусках парсеров
+func printRunReports(out io.Writer, reports []model.RunReport) error {
+	if len(reports) == 0 {
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tPARSER\tSTARTED\tDURATION\tSEEN\tNEW\tUPDATED\tDUPLICATES\tDROPPED\tSTOP WORDS\tFAILED CHANNELS\tERROR")
+
+	for _, report := range reports {
+		id := "-"
+		if report.ID > 0 {
+			id = fmt.Sprint(report.ID)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n",
+			id, report.Parser, report.StartedAt.Format("2006-01-02 15:04"), report.Duration().Round(time.Second),
+			report.Seen, report.New, report.Updated, report.Duplicates, report.Dropped, report.StopWords,
+			report.ChannelsFailed, report.Error)
+	}
+
+	return w.Flush()
+}
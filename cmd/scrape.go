@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/db"
+	"go.uber.org/zap"
+)
+
+// runAll выполняет полный цикл: однократный импорт данных из файлов в БД, сбор вакансий и пересчет технологий
+func runAll(ctx context.Context, a *app, args []string) error {
+	// Данные из файлов загружаются в БД один раз, чтобы не отменять правки через административный API.
+	// Повторно загрузить файлы можно командой import
+	populated, err := db.IsPopulated(ctx, a.repository)
+	switch {
+	case err != nil:
+		a.logger.Error("Ошибка проверки данных в базе", zap.Error(err))
+	case populated:
+		a.logger.Info("Данные уже загружены в базу, импорт файлов пропущен")
+	default:
+		if err := db.PopulateDatabase(ctx, a.repository, a.config.Data, a.logger); err != nil {
+			a.logger.Error("Ошибка при загрузке данных в базу", zap.Error(err))
+		}
+	}
+
+	return runScrape(ctx, a, args)
+}
+
+// runScrape собирает вакансии всеми парсерами и пересчитывает количество вакансий по технологиям
+func runScrape(ctx context.Context, a *app, args []string) error {
+	flags := flag.NewFlagSet("scrape", flag.ContinueOnError)
+	noRecount := flags.Bool("no-recount", false, "не пересчитывать количество вакансий по технологиям")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	reports, collectErr := a.service.CollectJobs(ctx)
+	if collectErr != nil {
+		a.logger.Error("Ошибка сбора вакансий",
+			zap.Error(collectErr),
+		)
+	} else {
+		a.logger.Info("Вакансии успешно собраны")
+	}
+
+	if err := printRunReports(a.out, reports); err != nil {
+		return err
+	}
+
+	// Дальше сигналы обрабатываются по умолчанию, чтобы повторный Ctrl+C прервал процесс
+	a.stopSignals()
+
+	// Проверяем здоровье каналов по результатам сбора, чтобы следующий сбор пропустил проблемные
+	if _, err := a.repository.CheckChannelHealth(context.WithoutCancel(ctx)); err != nil {
+		a.logger.Error("Ошибка проверки здоровья каналов", zap.Error(err))
+	}
+
+	if *noRecount {
+		return collectErr
+	}
+
+	// Счетчики пересчитываем и после прерывания, так как частичный результат уже сохранен.
+	// Перед пересчетом устаревшие вакансии переводятся в expired, чтобы не попасть в счетчики
+	recountCtx := context.WithoutCancel(ctx)
+	if _, err := a.repository.ExpireJobs(recountCtx); err != nil {
+		a.logger.Error("Ошибка перевода устаревших вакансий в expired", zap.Error(err))
+	}
+
+	if err := runRecount(recountCtx, a, nil); err != nil {
+		return err
+	}
+
+	return collectErr
+}
+
+// runRevisit заново собирает недавние посты, чтобы обновить измененные вакансии и найти закрытые и удаленные
+func runRevisit(ctx context.Context, a *app, args []string) error {
+	if err := a.service.RevisitJobs(ctx); err != nil {
+		return err
+	}
+
+	a.logger.Info("Повторный обход завершен")
+	return nil
+}
+
+// runRecount пересчитывает количество вакансий по технологиям
+func runRecount(ctx context.Context, a *app, args []string) error {
+	if err := a.repository.UpdateTechnologiesCount(ctx); err != nil {
+		return fmt.Errorf("обновление count в technologies: %w", err)
+	}
+
+	a.logger.Info("Таблица technologies обновлена: count пересчитан")
+	return nil
+}
+
+// runReclassify заново определяет технологии и стоп-слова сохраненных вакансий и пересчитывает счетчики
+func runReclassify(ctx context.Context, a *app, args []string) error {
+	changed, err := a.repository.ReclassifyJobs(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Переклассифицировано вакансий: %d\n", changed)
+
+	return runRecount(ctx, a, nil)
+}
+
+// runBackfill заново извлекает зарплату, формат работы, грейд, тип занятости и контакты сохраненных вакансий
+func runBackfill(ctx context.Context, a *app, args []string) error {
+	processed, err := a.repository.BackfillJobDetails(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Обработано вакансий: %d\n", processed)
+	return nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// highlightMarkers выделяет найденные слова в выводе поиска
+var highlightMarkers = strings.NewReplacer(model.HighlightStart, "«", model.HighlightEnd, "»")
+
+// runSearch ищет активные вакансии полнотекстовым поиском и выводит их по убыванию релевантности
+// вместе с фрагментами текста
+func runSearch(ctx context.Context, a *app, args []string) error {
+	flags := flag.NewFlagSet("search", flag.ContinueOnError)
+	limit := flags.Int("limit", 10, "максимальное количество вакансий")
+	technology := flags.String("technology", "", "только вакансии с этой технологией")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	query := strings.Join(flags.Args(), " ")
+	if strings.TrimSpace(query) == "" {
+		return errors.New("не задан поисковый запрос")
+	}
+
+	page, err := a.repository.SearchJobs(ctx, model.JobFilter{
+		Query:      query,
+		Technology: *technology,
+		Limit:      *limit,
+	}, 0)
+	if err != nil {
+		return err
+	}
+
+	if len(page.Results) == 0 {
+		fmt.Fprintln(a.out, "Ничего не найдено")
+		return nil
+	}
+
+	fmt.Fprintf(a.out, "Найдено вакансий: %d\n", page.Total)
+	for _, result := range page.Results {
+		fmt.Fprintf(a.out, "\n%s  %s  %s\n", result.Job.DatePosted.Format("2006-01-02"), result.Job.Slug, result.Job.SourceLink)
+		fmt.Fprintln(a.out, highlightMarkers.Replace(result.TitleHighlight))
+		fmt.Fprintf(a.out, "  %s\n", strings.Join(strings.Fields(highlightMarkers.Replace(result.Snippet)), " "))
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/feed"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/parser"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/scheduler"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/site"
+	"go.uber.org/zap"
+)
+
+// runServe запускает сбор вакансий по расписанию для каждого парсера и блокируется до отмены ctx.
+// Расписания задаются в секции scheduler конфигурации
+func runServe(ctx context.Context, a *app, args []string) error {
+	config := a.config.Scheduler
+
+	s := scheduler.NewScheduler(config, a.logger)
+
+	for _, p := range a.parsers {
+		name := p.Name()
+		spec := config.ScheduleFor(name)
+
+		err := s.Add(name, spec, func(ctx context.Context) error {
+			if _, err := a.service.CollectJobsFrom(ctx, name); err != nil {
+				return err
+			}
+
+			if _, err := a.repository.CheckChannelHealth(ctx); err != nil {
+				a.logger.Error("Ошибка проверки здоровья каналов", zap.Error(err))
+			}
+
+			return a.repository.UpdateTechnologiesCount(ctx)
+		})
+		if err != nil {
+			return err
+		}
+
+		a.logger.Info("Сбор вакансий запланирован",
+			zap.String("Parser", name),
+			zap.String("schedule", spec),
+		)
+
+		if _, ok := p.(parser.Revisiter); !ok {
+			continue
+		}
+
+		// Повторный обход недавних постов - отдельная задача со своим расписанием <источник>_revisit
+		revisitName := name + "_revisit"
+		revisitSpec := config.ScheduleFor(revisitName)
+
+		err = s.Add(revisitName, revisitSpec, func(ctx context.Context) error {
+			return a.service.RevisitJobsFrom(ctx, name)
+		})
+		if err != nil {
+			return err
+		}
+
+		a.logger.Info("Повторный обход запланирован",
+			zap.String("Parser", name),
+			zap.String("schedule", revisitSpec),
+		)
+	}
+
+	// Устаревание вакансий не зависит от источника - одна задача expire со своим расписанием
+	expireSpec := config.ScheduleFor("expire")
+
+	err := s.Add("expire", expireSpec, func(ctx context.Context) error {
+		expired, err := a.repository.ExpireJobs(ctx)
+		if err != nil || expired == 0 {
+			return err
+		}
+
+		return a.repository.UpdateTechnologiesCount(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	a.logger.Info("Устаревание вакансий запланировано", zap.String("schedule", expireSpec))
+
+	// Статические ленты пишутся, только если для них явно задано расписание feeds
+	if feedsSpec := config.Schedules["feeds"]; feedsSpec != "" {
+		generator := feed.NewGenerator(a.repository, a.config.Feed)
+
+		err := s.Add("feeds", feedsSpec, func(ctx context.Context) error {
+			_, err := generator.WriteFiles(ctx, a.config.Feed.Dir)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		a.logger.Info("Запись лент запланирована",
+			zap.String("schedule", feedsSpec),
+			zap.String("dir", a.config.Feed.Dir),
+		)
+	}
+
+	// Sitemap и статический сайт выгружаются, только если для них явно задано расписание site
+	if siteSpec := config.Schedules["site"]; siteSpec != "" {
+		exporter, err := site.NewExporter(a.repository, a.config.Site)
+		if err != nil {
+			return err
+		}
+
+		err = s.Add("site", siteSpec, func(ctx context.Context) error {
+			_, err := exporter.Export(ctx, a.config.Site.Dir)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		a.logger.Info("Выгрузка сайта запланирована",
+			zap.String("schedule", siteSpec),
+			zap.String("dir", a.config.Site.Dir),
+		)
+	}
+
+	s.Run(ctx)
+	return nil
+}
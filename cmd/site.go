@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/site"
+)
+
+// runSite выгружает sitemap.xml и, если задано site.html или флаг -html, статический сайт;
+// без него sitemap строится по site.job_url.
+// Каталог берется из site.dir конфигурации или флага -dir
+func runSite(ctx context.Context, a *app, args []string) error {
+	flags := flag.NewFlagSet("site", flag.ContinueOnError)
+	dir := flags.String("dir", a.config.Site.Dir, "каталог для выгрузки")
+	html := flags.Bool("html", a.config.Site.HTML, "выгрузить статические страницы")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	config := a.config.Site
+	config.HTML = *html
+
+	exporter, err := site.NewExporter(a.repository, config)
+	if err != nil {
+		return err
+	}
+
+	report, err := exporter.Export(ctx, *dir)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Вакансий: %d, технологий: %d\n", report.Jobs, report.Technologies)
+	fmt.Fprintf(a.out, "Ссылок в sitemap: %d, файлов sitemap: %d\n", report.URLs, report.Sitemaps)
+	if config.HTML {
+		fmt.Fprintf(a.out, "Страниц: %d\n", report.Pages)
+	}
+	fmt.Fprintf(a.out, "Каталог: %s\n", *dir)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+)
+
+// runStats выводит сводную статистику по вакансиям, каналам и технологиям
+func runStats(ctx context.Context, a *app, args []string) error {
+	stats, err := a.repository.GetStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	lastParsed := "-"
+	if stats.LastParsed != nil {
+		lastParsed = stats.LastParsed.Format("2006-01-02 15:04")
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Вакансий всего:\t%d\n", stats.JobsTotal)
+	fmt.Fprintf(w, "Активных:\t%d\n", stats.JobsActive)
+	fmt.Fprintf(w, "С определенной технологией:\t%d\n", stats.JobsClassified)
+	fmt.Fprintf(w, "Собрано за сутки:\t%d\n", stats.JobsLastDay)
+	fmt.Fprintf(w, "Каналов:\t%d\n", stats.ChannelsTotal)
+	fmt.Fprintf(w, "Последний парсинг:\t%s\n", lastParsed)
+
+	if len(stats.Statuses) > 0 {
+		fmt.Fprintln(w, "\nСТАТУС\tВАКАНСИЙ")
+		for _, sc := range stats.Statuses {
+			fmt.Fprintf(w, "%s\t%d\n", sc.Status, sc.Count)
+		}
+	}
+
+	if len(stats.Technologies) > 0 {
+		fmt.Fprintln(w, "\nТЕХНОЛОГИЯ\tВАКАНСИЙ")
+		for _, tc := range stats.Technologies {
+			fmt.Fprintf(w, "%s\t%d\n", tc.Technology, tc.Count)
+		}
+	}
+
+	return w.Flush()
+}
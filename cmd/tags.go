@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+)
+
+// runTags выводит хэштеги вакансий по убыванию количества вакансий и связанные с ними технологии
+func runTags(ctx context.Context, a *app, args []string) error {
+	flags := flag.NewFlagSet("tags", flag.ContinueOnError)
+	limit := flags.Int("limit", 50, "максимальное количество хэштегов, 0 - без ограничения")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	tags, err := a.repository.GetTags(ctx, *limit)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		fmt.Fprintln(a.out, "Хэштегов нет")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ХЭШТЕГ\tТЕХНОЛОГИЯ\tВАКАНСИЙ")
+	for _, tc := range tags {
+		technology := tc.Technology
+		if technology == "" {
+			technology = "-"
+		}
+		fmt.Fprintf(w, "#%s\t%s\t%d\n", tc.Tag, technology, tc.Count)
+	}
+
+	return w.Flush()
+}
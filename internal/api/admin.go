@@ -0,0 +1,74 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// maxAdminBodySize ограничивает размер тела административного запроса
+const maxAdminBodySize = 1 << 20
+
+// registerAdminRoutes регистрирует маршруты /admin для управления каналами, технологиями и стоп-словами.
+// Изменения сохраняются в БД и применяются со следующего сбора без перезапуска
+func (s *Server) registerAdminRoutes() {
+	routes := []struct {
+		pattern string
+		handler http.HandlerFunc
+	}{
+		{"GET /admin/channels", s.handleAdminListChannels},
+		{"POST /admin/channels", s.handleAdminAddChannel},
+		{"PATCH /admin/channels/{tag}", s.handleAdminUpdateChannel},
+		{"DELETE /admin/channels/{tag}", s.handleAdminRemoveChannel},
+		{"GET /admin/technologies", s.handleAdminListTechnologies},
+		{"PUT /admin/technologies/{technology}", s.handleAdminSaveTechnology},
+		{"DELETE /admin/technologies/{technology}", s.handleAdminRemoveTechnology},
+		{"GET /admin/stop-words", s.handleAdminListStopWords},
+		{"POST /admin/stop-words", s.handleAdminAddStopWord},
+		{"DELETE /admin/stop-words/{word}", s.handleAdminRemoveStopWord},
+	}
+
+	for _, route := range routes {
+		s.mux.Handle(route.pattern, s.requireAdmin(route.handler))
+	}
+}
+
+// requireAdmin пропускает запрос, только если в заголовке Authorization передан токен администратора
+func (s *Server) requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.config.AdminToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+			writeError(w, http.StatusUnauthorized, "требуется токен администратора")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// decodeBody разбирает JSON-тело запроса в value; неизвестные поля считаются ошибкой
+func decodeBody(w http.ResponseWriter, r *http.Request, value any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAdminBodySize))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(value); err != nil {
+		return fmt.Errorf("некорректное тело запроса: %w", err)
+	}
+
+	return nil
+}
+
+// writeChangeError отправляет 400 для ошибок проверки данных и 500 для остальных ошибок
+func (s *Server) writeChangeError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, model.ErrInvalidInput) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.writeInternalError(w, r, err)
+}
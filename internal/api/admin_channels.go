@@ -0,0 +1,132 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// adminChannelResponse - Telegram-канал с состоянием и проблемами, найденными проверкой здоровья
+type adminChannelResponse struct {
+	Tag                 string     `json:"tag"`
+	Enabled             bool       `json:"enabled"`
+	DisabledReason      string     `json:"disabled_reason,omitempty"`
+	Issues              []string   `json:"issues"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	RecentPosts         int        `json:"recent_posts"`
+	LastPosted          *time.Time `json:"last_posted,omitempty"`
+}
+
+type adminChannelsResponse struct {
+	Channels []adminChannelResponse `json:"channels"`
+}
+
+// addChannelRequest - тело POST /admin/channels
+type addChannelRequest struct {
+	Tag string `json:"tag"`
+}
+
+// updateChannelRequest - тело PATCH /admin/channels/{tag}
+type updateChannelRequest struct {
+	Enabled *bool `json:"enabled"`
+}
+
+// handleAdminListChannels отдает все каналы, включая отключенные
+func (s *Server) handleAdminListChannels(w http.ResponseWriter, r *http.Request) {
+	health, err := s.repository.GetChannelHealth(r.Context())
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	response := adminChannelsResponse{Channels: make([]adminChannelResponse, 0, len(health))}
+	for _, h := range health {
+		response.Channels = append(response.Channels, adminChannelResponse{
+			Tag:                 h.Tag,
+			Enabled:             h.Enabled,
+			DisabledReason:      h.DisabledReason,
+			Issues:              nonNil(h.Issues),
+			ConsecutiveFailures: h.ConsecutiveFailures,
+			RecentPosts:         h.RecentPosts,
+			LastPosted:          h.LastPosted,
+		})
+	}
+
+	s.writeJSON(w, r, response)
+}
+
+// handleAdminAddChannel добавляет канал; 409, если канал уже есть
+func (s *Server) handleAdminAddChannel(w http.ResponseWriter, r *http.Request) {
+	var request addChannelRequest
+	if err := decodeBody(w, r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	added, err := s.repository.AddChannel(r.Context(), request.Tag)
+	if err != nil {
+		s.writeChangeError(w, r, err)
+		return
+	}
+
+	if !added {
+		writeError(w, http.StatusConflict, "канал уже добавлен")
+		return
+	}
+
+	s.logger.Info("Канал добавлен через API", zap.String("tag", request.Tag))
+
+	writeJSONStatus(w, http.StatusCreated, request)
+}
+
+// handleAdminUpdateChannel включает или отключает канал
+func (s *Server) handleAdminUpdateChannel(w http.ResponseWriter, r *http.Request) {
+	var request updateChannelRequest
+	if err := decodeBody(w, r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if request.Enabled == nil {
+		writeError(w, http.StatusBadRequest, "не задано поле enabled")
+		return
+	}
+
+	tag := r.PathValue("tag")
+
+	found, err := s.repository.SetChannelEnabled(r.Context(), tag, *request.Enabled)
+	if err != nil {
+		s.writeChangeError(w, r, err)
+		return
+	}
+
+	if !found {
+		writeError(w, http.StatusNotFound, "канал не найден")
+		return
+	}
+
+	s.logger.Info("Канал изменен через API", zap.String("tag", tag), zap.Bool("enabled", *request.Enabled))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleAdminRemoveChannel удаляет канал; собранные из него вакансии остаются
+func (s *Server) handleAdminRemoveChannel(w http.ResponseWriter, r *http.Request) {
+	tag := r.PathValue("tag")
+
+	removed, err := s.repository.RemoveChannel(r.Context(), tag)
+	if err != nil {
+		s.writeChangeError(w, r, err)
+		return
+	}
+
+	if !removed {
+		writeError(w, http.StatusNotFound, "канал не найден")
+		return
+	}
+
+	s.logger.Info("Канал удален через API", zap.String("tag", tag))
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+type stopWordsResponse struct {
+	StopWords []string `json:"stop_words"`
+}
+
+// addStopWordRequest - тело POST /admin/stop-words
+type addStopWordRequest struct {
+	Word string `json:"word"`
+}
+
+// handleAdminListStopWords отдает стоп-слова
+func (s *Server) handleAdminListStopWords(w http.ResponseWriter, r *http.Request) {
+	stopWords, err := s.repository.GetStopWords(r.Context())
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	response := stopWordsResponse{StopWords: make([]string, 0, len(stopWords))}
+	for _, stopWord := range stopWords {
+		response.StopWords = append(response.StopWords, stopWord.Word)
+	}
+
+	s.writeJSON(w, r, response)
+}
+
+// handleAdminAddStopWord добавляет стоп-слово; 409, если оно уже есть
+func (s *Server) handleAdminAddStopWord(w http.ResponseWriter, r *http.Request) {
+	var request addStopWordRequest
+	if err := decodeBody(w, r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	added, err := s.repository.AddStopWord(r.Context(), request.Word)
+	if err != nil {
+		s.writeChangeError(w, r, err)
+		return
+	}
+
+	if !added {
+		writeError(w, http.StatusConflict, "стоп-слово уже добавлено")
+		return
+	}
+
+	s.logger.Info("Стоп-слово добавлено через API", zap.String("word", request.Word))
+
+	writeJSONStatus(w, http.StatusCreated, request)
+}
+
+// handleAdminRemoveStopWord удаляет стоп-слово
+func (s *Server) handleAdminRemoveStopWord(w http.ResponseWriter, r *http.Request) {
+	word := r.PathValue("word")
+
+	removed, err := s.repository.RemoveStopWord(r.Context(), word)
+	if err != nil {
+		s.writeChangeError(w, r, err)
+		return
+	}
+
+	if !removed {
+		writeError(w, http.StatusNotFound, "стоп-слово не найдено")
+		return
+	}
+
+	s.logger.Info("Стоп-слово удалено через API", zap.String("word", word))
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap"
+)
+
+// saveTechnologyRequest - тело PUT /admin/technologies/{technology}; синтаксис ключевых слов
+// тот же, что в technologies.csv
+type saveTechnologyRequest struct {
+	Keywords  []string `json:"keywords"`
+	SortOrder int      `json:"sort_order"`
+}
+
+// handleAdminListTechnologies отдает технологии с ключевыми словами в порядке sort_order
+func (s *Server) handleAdminListTechnologies(w http.ResponseWriter, r *http.Request) {
+	technologies, err := s.repository.GetTechnologies(r.Context())
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	response := technologiesResponse{Technologies: make([]technologyResponse, 0, len(technologies))}
+	for _, tech := range technologies {
+		response.Technologies = append(response.Technologies, newTechnologyResponse(tech))
+	}
+
+	s.writeJSON(w, r, response)
+}
+
+// handleAdminSaveTechnology добавляет технологию или заменяет ее ключевые слова и sort_order:
+// 201 для новой технологии, 200 для измененной
+func (s *Server) handleAdminSaveTechnology(w http.ResponseWriter, r *http.Request) {
+	var request saveTechnologyRequest
+	if err := decodeBody(w, r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tech := model.Technology{
+		Technology: r.PathValue("technology"),
+		Keywords:   request.Keywords,
+		SortOrder:  request.SortOrder,
+	}
+
+	created, err := s.repository.SaveTechnology(r.Context(), tech)
+	if err != nil {
+		s.writeChangeError(w, r, err)
+		return
+	}
+
+	s.logger.Info("Технология сохранена через API",
+		zap.String("technology", tech.Technology),
+		zap.Strings("keywords", tech.Keywords),
+		zap.Int("sortOrder", tech.SortOrder),
+		zap.Bool("created", created),
+	)
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+
+	writeJSONStatus(w, status, newTechnologyResponse(tech))
+}
+
+// handleAdminRemoveTechnology удаляет технологию
+func (s *Server) handleAdminRemoveTechnology(w http.ResponseWriter, r *http.Request) {
+	technology := r.PathValue("technology")
+
+	removed, err := s.repository.RemoveTechnology(r.Context(), technology)
+	if err != nil {
+		s.writeChangeError(w, r, err)
+		return
+	}
+
+	if !removed {
+		writeError(w, http.StatusNotFound, "технология не найдена")
+		return
+	}
+
+	s.logger.Info("Технология удалена через API", zap.String("technology", technology))
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/feed"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+const testAdminToken = "test-admin-token-0123456789"
+
+// newAdminServer создает сервер API с включенным административным API
+func newAdminServer(t *testing.T) (*Server, *test.MockRepository) {
+	repo := test.NewMockRepository(zaptest.NewLogger(t))
+
+	config := DefaultConfig()
+	config.AdminToken = testAdminToken
+
+	return NewServer(repo, feed.NewGenerator(repo, feed.DefaultConfig()), zaptest.NewLogger(t), config), repo
+}
+
+// admin выполняет административный запрос с токеном и возвращает ответ
+func admin(s *Server, method, target, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, target, strings.NewReader(body))
+	request.Header.Set("Authorization", "Bearer "+testAdminToken)
+
+	recorder := httptest.NewRecorder()
+	s.Handler().ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestAdminAuth(t *testing.T) {
+	t.Run("без токена или с чужим токеном", func(t *testing.T) {
+		// GIVEN: Включенный административный API
+		s, _ := newAdminServer(t)
+
+		// WHEN/THEN: Запросы без токена и с неверным токеном отклоняются
+		assert.Equal(t, http.StatusUnauthorized, get(s, "/admin/channels").Code)
+		assert.Equal(t, http.StatusUnauthorized, get(s, "/admin/channels", "Authorization", "Bearer wrong").Code)
+		assert.Equal(t, http.StatusOK, get(s, "/admin/channels", "Authorization", "Bearer "+testAdminToken).Code)
+	})
+
+	t.Run("административный API отключен", func(t *testing.T) {
+		// GIVEN: Сервер без токена администратора
+		s, _ := newTestServer(t)
+
+		// WHEN/THEN: Административных маршрутов нет
+		assert.Equal(t, http.StatusNotFound, get(s, "/admin/channels", "Authorization", "Bearer ").Code)
+	})
+}
+
+func TestAdminChannels(t *testing.T) {
+	// GIVEN: Административный API без каналов
+	s, repo := newAdminServer(t)
+
+	// WHEN/THEN: Канал добавляется, повторное добавление - конфликт, некорректный тег - 400
+	assert.Equal(t, http.StatusCreated, admin(s, http.MethodPost, "/admin/channels", `{"tag": "golang_jobs"}`).Code)
+	assert.Equal(t, http.StatusConflict, admin(s, http.MethodPost, "/admin/channels", `{"tag": "golang_jobs"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, admin(s, http.MethodPost, "/admin/channels", `{"tag": "@golang jobs"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, admin(s, http.MethodPost, "/admin/channels", `{"channel": "golang_jobs"}`).Code)
+	require.Len(t, repo.TelegramChannels, 1)
+
+	// WHEN/THEN: Канал отключается; без поля enabled и для неизвестного канала - ошибка
+	assert.Equal(t, http.StatusBadRequest, admin(s, http.MethodPatch, "/admin/channels/golang_jobs", `{}`).Code)
+	assert.Equal(t, http.StatusNotFound, admin(s, http.MethodPatch, "/admin/channels/missing", `{"enabled": false}`).Code)
+	assert.Equal(t, http.StatusNoContent, admin(s, http.MethodPatch, "/admin/channels/golang_jobs", `{"enabled": false}`).Code)
+	assert.Empty(t, repo.TelegramChannels)
+
+	// WHEN/THEN: Удаление неизвестного канала
+	assert.Equal(t, http.StatusNotFound, admin(s, http.MethodDelete, "/admin/channels/golang_jobs", "").Code)
+}
+
+func TestAdminTechnologies(t *testing.T) {
+	// GIVEN: Административный API с одной технологией
+	s, repo := newAdminServer(t)
+	repo.Technologies = []model.Technology{test.CreateMockTechnology(1, "golang", 0, "go")}
+
+	// WHEN/THEN: Изменение существующей технологии - 200, новая технология - 201
+	assert.Equal(t, http.StatusOK,
+		admin(s, http.MethodPut, "/admin/technologies/golang", `{"keywords": ["go", "golang"], "sort_order": -5}`).Code)
+	assert.Equal(t, http.StatusCreated,
+		admin(s, http.MethodPut, "/admin/technologies/rust", `{"keywords": ["rust"]}`).Code)
+	assert.Equal(t, http.StatusBadRequest,
+		admin(s, http.MethodPut, "/admin/technologies/rust", `{"sort_order": 500}`).Code)
+
+	require.Len(t, repo.Technologies, 2)
+	assert.Equal(t, []string{"go", "golang"}, repo.Technologies[0].Keywords)
+	assert.Equal(t, -5, repo.Technologies[0].SortOrder)
+
+	// WHEN/THEN: Технология удаляется
+	assert.Equal(t, http.StatusNoContent, admin(s, http.MethodDelete, "/admin/technologies/rust", "").Code)
+	assert.Equal(t, http.StatusNotFound, admin(s, http.MethodDelete, "/admin/technologies/rust", "").Code)
+	assert.Len(t, repo.Technologies, 1)
+}
+
+func TestAdminStopWords(t *testing.T) {
+	// GIVEN: Административный API
+	s, repo := newAdminServer(t)
+
+	// WHEN/THEN: Стоп-слово добавляется, дубликат и пустое слово отклоняются
+	assert.Equal(t, http.StatusCreated, admin(s, http.MethodPost, "/admin/stop-words", `{"word": "казино"}`).Code)
+	assert.Equal(t, http.StatusConflict, admin(s, http.MethodPost, "/admin/stop-words", `{"word": "казино"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, admin(s, http.MethodPost, "/admin/stop-words", `{"word": " "}`).Code)
+
+	var list stopWordsResponse
+	decode(t, admin(s, http.MethodGet, "/admin/stop-words", ""), &list)
+	assert.Equal(t, []string{"казино"}, list.StopWords)
+
+	// WHEN/THEN: Стоп-слово удаляется
+	assert.Equal(t, http.StatusNoContent, admin(s, http.MethodDelete, "/admin/stop-words/казино", "").Code)
+	assert.Empty(t, repo.StopWords)
+}
@@ -0,0 +1,24 @@
+package api
+
+import "net/http"
+
+// handleListChannels отдает включенные Telegram-каналы, из которых собираются вакансии
+func (s *Server) handleListChannels(w http.ResponseWriter, r *http.Request) {
+	channels, err := s.repository.GetTelegramChannels(r.Context())
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	response := channelsResponse{Channels: make([]channelResponse, 0, len(channels))}
+	for _, channel := range channels {
+		response.Channels = append(response.Channels, channelResponse{
+			Tag:            channel.Tag,
+			PostsParsed:    channel.PostsParsed,
+			DateAdded:      channel.DateChannelAdded,
+			DateLastParsed: channel.DateLastParsed,
+		})
+	}
+
+	s.writeJSON(w, r, response)
+}
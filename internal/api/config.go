@@ -0,0 +1,30 @@
+package api
+
+import "time"
+
+// Config задает параметры HTTP API
+type Config struct {
+	// Addr - адрес, на котором слушает HTTP-сервер, например ":8080"
+	Addr string `yaml:"addr"`
+	// DefaultLimit - размер страницы /jobs, если параметр limit не указан
+	DefaultLimit int `yaml:"default_limit"`
+	// MaxLimit - максимальный размер страницы /jobs
+	MaxLimit int `yaml:"max_limit"`
+	// AdminToken - токен административных маршрутов /admin, передается в заголовке
+	// "Authorization: Bearer <токен>". Пустой токен отключает административный API
+	AdminToken string `yaml:"admin_token"`
+	// ReadTimeout и WriteTimeout - таймауты чтения запроса и записи ответа
+	ReadTimeout  time.Duration `yaml:"read_timeout"`
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+}
+
+// DefaultConfig возвращает конфигурацию HTTP API по умолчанию
+func DefaultConfig() Config {
+	return Config{
+		Addr:         ":8080",
+		DefaultLimit: 20,
+		MaxLimit:     100,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// errInvalidCursor - курсор не был выдан API или поврежден
+var errInvalidCursor = errors.New("некорректный курсор")
+
+// encodeCursor кодирует курсор в непрозрачную строку для параметра cursor
+func encodeCursor(cursor *model.JobCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	raw := fmt.Sprintf("%d.%d", cursor.DatePosted.UnixNano(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor разбирает строку, полученную от encodeCursor
+func decodeCursor(value string) (*model.JobCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return nil, errInvalidCursor
+	}
+
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	jobID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &model.JobCursor{DatePosted: time.Unix(0, unixNano).UTC(), ID: jobID}, nil
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/feed"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/utils"
+)
+
+// handleFeed отдает общую ленту последних вакансий: /feeds/rss.xml, /feeds/atom.xml
+// или /feeds/feed.json. Маршруты лент повторяют раскладку статических файлов feed.WriteFiles
+func (s *Server) handleFeed(w http.ResponseWriter, r *http.Request) {
+	s.writeFeed(w, r, feed.Filter{})
+}
+
+// handleTechnologyFeed отдает ленту технологии по ее slug (utils.TechnologySlug)
+func (s *Server) handleTechnologyFeed(w http.ResponseWriter, r *http.Request) {
+	technologies, err := s.repository.GetTechnologies(r.Context())
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	slug := r.PathValue("technology")
+	for _, tech := range technologies {
+		if utils.TechnologySlug(tech.Technology) == slug {
+			s.writeFeed(w, r, feed.Filter{Technology: tech.Technology})
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "технология не найдена")
+}
+
+// handleTagFeed отдает ленту вакансий с хэштегом
+func (s *Server) handleTagFeed(w http.ResponseWriter, r *http.Request) {
+	s.writeFeed(w, r, feed.Filter{Tag: r.PathValue("tag")})
+}
+
+// handleChannelFeed отдает ленту вакансий канала
+func (s *Server) handleChannelFeed(w http.ResponseWriter, r *http.Request) {
+	s.writeFeed(w, r, feed.Filter{Channel: r.PathValue("channel")})
+}
+
+// writeFeed собирает ленту по фильтру в формате, определяемом именем файла из пути
+func (s *Server) writeFeed(w http.ResponseWriter, r *http.Request, filter feed.Filter) {
+	format, ok := feed.FormatByFileName(r.PathValue("file"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "лента не найдена")
+		return
+	}
+
+	built, err := s.feeds.Build(r.Context(), filter, format)
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := feed.Render(&buf, built, format); err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	writeCached(w, r, format.ContentType(), buf.Bytes())
+}
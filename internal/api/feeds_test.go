@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+func TestFeeds(t *testing.T) {
+	t.Run("общая лента во всех форматах", func(t *testing.T) {
+		// GIVEN: Три активные вакансии
+		s, _ := newTestServer(t)
+
+		for file, contentType := range map[string]string{
+			"rss.xml":   "application/rss+xml; charset=utf-8",
+			"atom.xml":  "application/atom+xml; charset=utf-8",
+			"feed.json": "application/feed+json; charset=utf-8",
+		} {
+			// WHEN: Запрашиваем ленту
+			response := get(s, "/feeds/"+file)
+
+			// THEN: Лента отдана с нужным типом и содержит slug вакансий
+			require.Equal(t, http.StatusOK, response.Code, file)
+			assert.Equal(t, contentType, response.Header().Get("Content-Type"))
+			assert.NotEmpty(t, response.Header().Get("ETag"))
+			assert.Contains(t, response.Body.String(), "testovaya-vakansiya-3")
+		}
+	})
+
+	t.Run("ленты технологии, хэштега и канала", func(t *testing.T) {
+		// GIVEN: Вакансии по golang и java, у java есть хэштег
+		s, repo := newTestServer(t)
+		repo.Technologies = []model.Technology{{ID: 1, Technology: "golang"}, {ID: 2, Technology: "java"}}
+		repo.Jobs[1].Tags = []string{"backend"}
+
+		// WHEN: Запрашиваем ленту технологии по slug
+		byTechnology := get(s, "/feeds/technologies/golang/rss.xml")
+
+		// THEN: В ленте только вакансии golang
+		require.Equal(t, http.StatusOK, byTechnology.Code)
+		assert.Contains(t, byTechnology.Body.String(), "testovaya-vakansiya-1")
+		assert.NotContains(t, byTechnology.Body.String(), "testovaya-vakansiya-2")
+
+		// WHEN: Запрашиваем ленты хэштега и канала
+		byTag := get(s, "/feeds/tags/backend/feed.json")
+		byChannel := get(s, "/feeds/channels/test_channel/atom.xml")
+
+		// THEN: В ленте хэштега одна вакансия, в ленте канала - все
+		require.Equal(t, http.StatusOK, byTag.Code)
+		assert.Contains(t, byTag.Body.String(), "testovaya-vakansiya-2")
+		assert.NotContains(t, byTag.Body.String(), "testovaya-vakansiya-1")
+		require.Equal(t, http.StatusOK, byChannel.Code)
+		assert.Contains(t, byChannel.Body.String(), "testovaya-vakansiya-1")
+	})
+
+	t.Run("неизвестные технология и формат", func(t *testing.T) {
+		// GIVEN: Сервер с вакансиями
+		s, _ := newTestServer(t)
+
+		// WHEN: Запрашиваем ленту несуществующей технологии и неизвестный файл
+		// THEN: Получаем 404
+		assert.Equal(t, http.StatusNotFound, get(s, "/feeds/technologies/cobol/rss.xml").Code)
+		assert.Equal(t, http.StatusNotFound, get(s, "/feeds/index.html").Code)
+	})
+
+	t.Run("304 по ETag", func(t *testing.T) {
+		// GIVEN: Полученная лента и ее ETag
+		s, _ := newTestServer(t)
+		first := get(s, "/feeds/rss.xml")
+		require.Equal(t, http.StatusOK, first.Code)
+
+		// WHEN: Повторяем запрос с If-None-Match
+		second := get(s, "/feeds/rss.xml", "If-None-Match", first.Header().Get("ETag"))
+
+		// THEN: Лента не изменилась
+		assert.Equal(t, http.StatusNotModified, second.Code)
+	})
+}
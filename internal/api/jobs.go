@@ -0,0 +1,122 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// publicStatuses - статусы вакансий, доступные через API; скрытые вручную и спам не отдаются
+var publicStatuses = []string{model.JobStatusActive, model.JobStatusClosed, model.JobStatusDeleted, model.JobStatusExpired}
+
+// handleListJobs отдает страницу вакансий по фильтрам: technology, tag, channel, from, to,
+// q (полнотекстовый запрос), status (через запятую), cursor и limit. В отличие от /search
+// вакансии упорядочены по дате публикации
+func (s *Server) handleListJobs(w http.ResponseWriter, r *http.Request) {
+	filter, err := s.parseJobFilter(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := s.repository.ListJobs(r.Context(), filter)
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	response := jobsResponse{
+		Jobs:       make([]jobResponse, 0, len(page.Jobs)),
+		NextCursor: encodeCursor(page.Next),
+	}
+	for _, job := range page.Jobs {
+		response.Jobs = append(response.Jobs, newJobResponse(job, false))
+	}
+
+	s.writeJSON(w, r, response)
+}
+
+// handleGetJob отдает вакансию с текстом по slug
+func (s *Server) handleGetJob(w http.ResponseWriter, r *http.Request) {
+	job, found, err := s.repository.GetJobBySlug(r.Context(), r.PathValue("slug"))
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	if !found || !slices.Contains(publicStatuses, job.Status) {
+		writeError(w, http.StatusNotFound, "вакансия не найдена")
+		return
+	}
+
+	s.writeJSON(w, r, newJobResponse(job, true))
+}
+
+// parseJobFilter собирает фильтр вакансий из параметров запроса
+func (s *Server) parseJobFilter(query url.Values) (model.JobFilter, error) {
+	filter := model.JobFilter{
+		Technology: strings.TrimSpace(query.Get("technology")),
+		Tag:        strings.TrimSpace(query.Get("tag")),
+		Channel:    strings.TrimSpace(query.Get("channel")),
+		Query:      strings.TrimSpace(query.Get("q")),
+		Limit:      s.config.DefaultLimit,
+	}
+
+	var err error
+	if filter.From, err = parseDate(query.Get("from")); err != nil {
+		return filter, fmt.Errorf("from: %w", err)
+	}
+	if filter.To, err = parseDate(query.Get("to")); err != nil {
+		return filter, fmt.Errorf("to: %w", err)
+	}
+
+	if value := query.Get("status"); value != "" {
+		for _, status := range strings.Split(value, ",") {
+			status = strings.TrimSpace(status)
+			if !slices.Contains(publicStatuses, status) {
+				return filter, fmt.Errorf("status: неизвестный статус %q", status)
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			return filter, fmt.Errorf("limit: должен быть положительным числом")
+		}
+		filter.Limit = min(limit, s.config.MaxLimit)
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		if filter.After, err = decodeCursor(value); err != nil {
+			return filter, fmt.Errorf("cursor: %w", err)
+		}
+	}
+
+	return filter, nil
+}
+
+// parseDate разбирает дату в формате RFC 3339 или YYYY-MM-DD (полночь UTC); пустая строка - нулевое время
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if date, err := time.Parse(time.RFC3339, value); err == nil {
+		return date, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("некорректная дата %q, ожидается YYYY-MM-DD или RFC 3339", value)
+	}
+
+	return date, nil
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// errorResponse - тело ответа с ошибкой
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// writeJSON отправляет value в формате JSON с ETag, вычисленным по телу ответа.
+// Если ETag совпадает с If-None-Match запроса, отправляется 304 без тела
+func (s *Server) writeJSON(w http.ResponseWriter, r *http.Request, value any) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	writeCached(w, r, "application/json; charset=utf-8", body)
+}
+
+// writeCached отправляет body с типом contentType и ETag, вычисленным по телу ответа.
+// Если ETag совпадает с If-None-Match запроса, отправляется 304 без тела
+func writeCached(w http.ResponseWriter, r *http.Request, contentType string, body []byte) {
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// writeJSONStatus отправляет value в формате JSON с кодом status без ETag,
+// например результат изменения данных
+func writeJSONStatus(w http.ResponseWriter, status int, value any) {
+	body, _ := json.Marshal(value)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// writeError отправляет ошибку клиента с кодом status
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSONStatus(w, status, errorResponse{Error: message})
+}
+
+// writeInternalError логирует ошибку и отправляет 500 без подробностей
+func (s *Server) writeInternalError(w http.ResponseWriter, r *http.Request, err error) {
+	s.logger.Error("Ошибка обработки запроса API",
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+		zap.Error(err),
+	)
+	writeError(w, http.StatusInternalServerError, "внутренняя ошибка сервера")
+}
+
+// etagMatches сообщает, что заголовок If-None-Match содержит etag или "*".
+// Слабые валидаторы (W/) сравниваются по значению, как требует RFC 9110 для If-None-Match
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// jobResponse - вакансия в ответах API. Текст вакансии отдается только в /jobs/{slug}
+type jobResponse struct {
+	ID             int64             `json:"id"`
+	Slug           string            `json:"slug"`
+	Title          string            `json:"title"`
+	Content        string            `json:"content,omitempty"`
+	Text           string            `json:"text,omitempty"`
+	SourceLink     string            `json:"source_link"`
+	Status         string            `json:"status"`
+	MainTechnology string            `json:"main_technology,omitempty"`
+	Technologies   []technologyScore `json:"technologies"`
+	Tags           []string          `json:"tags"`
+	Salary         *salaryResponse   `json:"salary,omitempty"`
+	Location       locationResponse  `json:"location"`
+	Seniority      []string          `json:"seniority"`
+	EmploymentType []string          `json:"employment_type"`
+	Contacts       contactsResponse  `json:"contacts"`
+	DatePosted     time.Time         `json:"date_posted"`
+	DateParsed     time.Time         `json:"date_parsed"`
+}
+
+// technologyScore - технология вакансии и ее релевантность
+type technologyScore struct {
+	Technology string `json:"technology"`
+	Score      int    `json:"score"`
+}
+
+type salaryResponse struct {
+	Min      int64  `json:"min,omitempty"`
+	Max      int64  `json:"max,omitempty"`
+	Currency string `json:"currency,omitempty"`
+	Period   string `json:"period,omitempty"`
+	Tax      string `json:"tax,omitempty"`
+	MinBase  int64  `json:"min_base,omitempty"`
+	MaxBase  int64  `json:"max_base,omitempty"`
+}
+
+type locationResponse struct {
+	WorkFormat  string   `json:"work_format,omitempty"`
+	Relocation  bool     `json:"relocation"`
+	Countries   []string `json:"countries"`
+	Cities      []string `json:"cities"`
+	TimezoneMin *int     `json:"timezone_min,omitempty"`
+	TimezoneMax *int     `json:"timezone_max,omitempty"`
+}
+
+type contactsResponse struct {
+	Company   string   `json:"company,omitempty"`
+	Telegram  []string `json:"telegram"`
+	Emails    []string `json:"emails"`
+	Phones    []string `json:"phones"`
+	ApplyURLs []string `json:"apply_urls"`
+}
+
+// jobsResponse - страница вакансий; next_cursor передается в параметре cursor для следующей страницы
+type jobsResponse struct {
+	Jobs       []jobResponse `json:"jobs"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+type technologyResponse struct {
+	Technology string   `json:"technology"`
+	Count      int64    `json:"count"`
+	SortOrder  int      `json:"sort_order"`
+	Keywords   []string `json:"keywords"`
+	Tags       []string `json:"tags"`
+}
+
+type technologiesResponse struct {
+	Technologies []technologyResponse `json:"technologies"`
+}
+
+type channelResponse struct {
+	Tag            string     `json:"tag"`
+	PostsParsed    int64      `json:"posts_parsed"`
+	DateAdded      time.Time  `json:"date_added"`
+	DateLastParsed *time.Time `json:"date_last_parsed,omitempty"`
+}
+
+type channelsResponse struct {
+	Channels []channelResponse `json:"channels"`
+}
+
+// newJobResponse преобразует вакансию в ответ API; withContent добавляет текст вакансии.
+// Пустые списки отдаются как [], а не null
+func newJobResponse(job model.JobRaw, withContent bool) jobResponse {
+	response := jobResponse{
+		ID:             job.ID,
+		Slug:           job.Slug,
+		Title:          job.Title,
+		SourceLink:     job.SourceLink,
+		Status:         job.Status,
+		MainTechnology: job.MainTechnology,
+		Technologies:   make([]technologyScore, 0, len(job.Technologies)),
+		Tags:           nonNil(job.Tags),
+		Location: locationResponse{
+			WorkFormat:  job.Location.WorkFormat,
+			Relocation:  job.Location.Relocation,
+			Countries:   nonNil(job.Location.Countries),
+			Cities:      nonNil(job.Location.Cities),
+			TimezoneMin: job.Location.TimezoneMin,
+			TimezoneMax: job.Location.TimezoneMax,
+		},
+		Seniority:      nonNil(job.Seniority),
+		EmploymentType: nonNil(job.EmploymentType),
+		Contacts: contactsResponse{
+			Company:   job.Contacts.Company,
+			Telegram:  nonNil(job.Contacts.Telegram),
+			Emails:    nonNil(job.Contacts.Emails),
+			Phones:    nonNil(job.Contacts.Phones),
+			ApplyURLs: nonNil(job.Contacts.ApplyURLs),
+		},
+		DatePosted: job.DatePosted,
+		DateParsed: job.DateParsed,
+	}
+
+	if withContent {
+		response.Content = job.Content
+		response.Text = job.ContentPure
+	}
+
+	for _, tech := range job.Technologies {
+		response.Technologies = append(response.Technologies, technologyScore{Technology: tech.Technology, Score: tech.Score})
+	}
+
+	if salary := job.Salary; salary != nil {
+		response.Salary = &salaryResponse{
+			Min:      salary.Min,
+			Max:      salary.Max,
+			Currency: salary.Currency,
+			Period:   salary.Period,
+			Tax:      salary.Tax,
+			MinBase:  salary.MinBase,
+			MaxBase:  salary.MaxBase,
+		}
+	}
+
+	return response
+}
+
+// newTechnologyResponse преобразует технологию в ответ API
+func newTechnologyResponse(tech model.Technology) technologyResponse {
+	return technologyResponse{
+		Technology: tech.Technology,
+		Count:      tech.Count,
+		SortOrder:  tech.SortOrder,
+		Keywords:   nonNil(tech.Keywords),
+		Tags:       nonNil(tech.Tags),
+	}
+}
+
+// nonNil возвращает пустой список вместо nil, чтобы в JSON был [], а не null
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
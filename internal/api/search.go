@@ -0,0 +1,85 @@
+package api
+
+import (
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// highlightReplacer заменяет маркеры найденных слов на разметку <mark> в уже экранированном тексте
+var highlightReplacer = strings.NewReplacer(model.HighlightStart, "<mark>", model.HighlightEnd, "</mark>")
+
+// searchResultResponse - найденная вакансия; title_html и snippet_html - экранированный HTML,
+// в котором найденные слова выделены тегом <mark>
+type searchResultResponse struct {
+	jobResponse
+	Rank        float64 `json:"rank"`
+	TitleHTML   string  `json:"title_html"`
+	SnippetHTML string  `json:"snippet_html"`
+}
+
+// searchResponse - страница результатов поиска; next_offset передается в параметре offset
+// для следующей страницы
+type searchResponse struct {
+	Results    []searchResultResponse `json:"results"`
+	Total      int64                  `json:"total"`
+	NextOffset int                    `json:"next_offset,omitempty"`
+}
+
+// handleSearch ищет вакансии полнотекстовым поиском по параметру q; фильтры те же, что у /jobs,
+// а страницы задаются параметрами offset и limit
+func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	filter, err := s.parseJobFilter(query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if filter.Query == "" {
+		writeError(w, http.StatusBadRequest, "q: не задан поисковый запрос")
+		return
+	}
+
+	offset := 0
+	if value := query.Get("offset"); value != "" {
+		if offset, err = strconv.Atoi(value); err != nil || offset < 0 {
+			writeError(w, http.StatusBadRequest, "offset: должен быть неотрицательным числом")
+			return
+		}
+	}
+
+	page, err := s.repository.SearchJobs(r.Context(), filter, offset)
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	response := searchResponse{
+		Results: make([]searchResultResponse, 0, len(page.Results)),
+		Total:   page.Total,
+	}
+	for _, result := range page.Results {
+		response.Results = append(response.Results, searchResultResponse{
+			jobResponse: newJobResponse(result.Job, false),
+			Rank:        result.Rank,
+			TitleHTML:   highlightHTML(result.TitleHighlight),
+			SnippetHTML: highlightHTML(result.Snippet),
+		})
+	}
+
+	if next := offset + len(page.Results); len(page.Results) > 0 && int64(next) < page.Total {
+		response.NextOffset = next
+	}
+
+	s.writeJSON(w, r, response)
+}
+
+// highlightHTML экранирует текст с маркерами model.Highlight* и выделяет найденные слова тегом <mark>
+func highlightHTML(text string) string {
+	return highlightReplacer.Replace(html.EscapeString(text))
+}
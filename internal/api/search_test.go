@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+func TestSearch(t *testing.T) {
+	t.Run("результаты по релевантности со страницами", func(t *testing.T) {
+		// GIVEN: Две вакансии со словом kafka, во второй оно встречается чаще
+		s, repo := newTestServer(t)
+		repo.Jobs[0].ContentPure = "Ищем разработчика, опыт с kafka"
+		repo.Jobs[1].ContentPure = "kafka, kafka и еще раз kafka"
+
+		// WHEN: Ищем по одной вакансии на странице
+		recorder := get(s, "/search?q=kafka&limit=1&status=active")
+
+		// THEN: Первой идет более релевантная вакансия, есть смещение следующей страницы
+		require.Equal(t, http.StatusOK, recorder.Code)
+		var page searchResponse
+		decode(t, recorder, &page)
+		require.Len(t, page.Results, 1)
+		assert.Equal(t, int64(2), page.Total)
+		assert.Equal(t, int64(2), page.Results[0].ID)
+		assert.Contains(t, page.Results[0].SnippetHTML, "<mark>kafka</mark>")
+		assert.Equal(t, 1, page.NextOffset)
+
+		// WHEN: Запрашиваем следующую страницу
+		var next searchResponse
+		decode(t, get(s, "/search?q=kafka&limit=1&offset=1"), &next)
+
+		// THEN: Получена последняя вакансия без смещения следующей страницы
+		require.Len(t, next.Results, 1)
+		assert.Equal(t, int64(1), next.Results[0].ID)
+		assert.Zero(t, next.NextOffset)
+	})
+
+	t.Run("некорректные параметры", func(t *testing.T) {
+		// GIVEN: Сервер API
+		s, _ := newTestServer(t)
+
+		// WHEN/THEN: Без запроса и с отрицательным смещением - 400
+		assert.Equal(t, http.StatusBadRequest, get(s, "/search").Code)
+		assert.Equal(t, http.StatusBadRequest, get(s, "/search?q=go&offset=-1").Code)
+	})
+}
+
+func TestHighlightHTML(t *testing.T) {
+	// GIVEN: Фрагмент с HTML в тексте вакансии и выделенным словом
+	snippet := "<script>alert(1)</script> опыт с " + model.HighlightStart + "Go" + model.HighlightEnd
+
+	// WHEN: Преобразуем фрагмент в HTML
+	result := highlightHTML(snippet)
+
+	// THEN: Текст экранирован, выделение заменено тегом <mark>
+	assert.Equal(t, "&lt;script&gt;alert(1)&lt;/script&gt; опыт с <mark>Go</mark>", result)
+}
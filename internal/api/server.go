@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/feed"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository"
+	"go.uber.org/zap"
+)
+
+// shutdownTimeout - сколько ждать завершения текущих запросов при остановке сервера
+const shutdownTimeout = 10 * time.Second
+
+// Server - HTTP API для чтения собранных вакансий, технологий и каналов. При заданном
+// генераторе лент отдает ленты /feeds, при заданном AdminToken также обслуживает
+// административные маршруты /admin
+type Server struct {
+	repository repository.JobsRepository
+	feeds      *feed.Generator
+	logger     *zap.Logger
+	config     Config
+	mux        *http.ServeMux
+}
+
+func NewServer(repository repository.JobsRepository, feeds *feed.Generator, logger *zap.Logger, config Config) *Server {
+	s := &Server{
+		repository: repository,
+		feeds:      feeds,
+		logger:     logger,
+		config:     config,
+		mux:        http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /jobs", s.handleListJobs)
+	s.mux.HandleFunc("GET /jobs/{slug}", s.handleGetJob)
+	s.mux.HandleFunc("GET /search", s.handleSearch)
+	s.mux.HandleFunc("GET /technologies", s.handleListTechnologies)
+	s.mux.HandleFunc("GET /channels", s.handleListChannels)
+
+	if feeds != nil {
+		s.mux.HandleFunc("GET /feeds/{file}", s.handleFeed)
+		s.mux.HandleFunc("GET /feeds/technologies/{technology}/{file}", s.handleTechnologyFeed)
+		s.mux.HandleFunc("GET /feeds/tags/{tag}/{file}", s.handleTagFeed)
+		s.mux.HandleFunc("GET /feeds/channels/{channel}/{file}", s.handleChannelFeed)
+	}
+
+	if config.AdminToken != "" {
+		s.registerAdminRoutes()
+	}
+
+	return s
+}
+
+// Handler возвращает обработчик всех маршрутов API
+func (s *Server) Handler() http.Handler {
+	return s.mux
+}
+
+// Run запускает HTTP-сервер на config.Addr и блокируется до отмены ctx, после чего
+// дожидается завершения текущих запросов
+func (s *Server) Run(ctx context.Context) error {
+	op := "api.Run"
+
+	server := &http.Server{
+		Addr:         s.config.Addr,
+		Handler:      s.Handler(),
+		ReadTimeout:  s.config.ReadTimeout,
+		WriteTimeout: s.config.WriteTimeout,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.ListenAndServe()
+	}()
+
+	s.logger.Info("HTTP API запущен", zap.String("addr", s.config.Addr))
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("%s: %w", op, err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("%s: остановка сервера: %w", op, err)
+	}
+
+	if err := <-errs; !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.logger.Info("HTTP API остановлен")
+
+	return nil
+}
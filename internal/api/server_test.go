@@ -0,0 +1,214 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/feed"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+// newTestServer создает сервер API поверх мок-репозитория с тремя вакансиями разных дней
+func newTestServer(t *testing.T) (*Server, *test.MockRepository) {
+	repo := test.NewMockRepository(zaptest.NewLogger(t))
+
+	posted := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i, technology := range []string{"golang", "java", "golang"} {
+		job := test.CreateMockJob(int64(i+1), technology)
+		job.DatePosted = posted.AddDate(0, 0, i)
+		job.Technologies = []model.JobTechnology{{TechnologyID: int64(i + 1), Technology: technology, Score: 3}}
+		repo.Jobs = append(repo.Jobs, job)
+	}
+
+	return NewServer(repo, feed.NewGenerator(repo, feed.DefaultConfig()), zaptest.NewLogger(t), DefaultConfig()), repo
+}
+
+// get выполняет GET-запрос к серверу и возвращает ответ
+func get(s *Server, target string, headers ...string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, target, nil)
+	for i := 0; i+1 < len(headers); i += 2 {
+		request.Header.Set(headers[i], headers[i+1])
+	}
+
+	recorder := httptest.NewRecorder()
+	s.Handler().ServeHTTP(recorder, request)
+	return recorder
+}
+
+// decode разбирает JSON-тело ответа в value
+func decode(t *testing.T, recorder *httptest.ResponseRecorder, value any) {
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), value))
+}
+
+func TestListJobs(t *testing.T) {
+	t.Run("страницы по курсору от новых к старым", func(t *testing.T) {
+		// GIVEN: Три активные вакансии
+		s, _ := newTestServer(t)
+
+		// WHEN: Запрашиваем первую страницу из двух вакансий
+		first := get(s, "/jobs?limit=2")
+
+		// THEN: Получены две самые новые вакансии и курсор следующей страницы
+		require.Equal(t, http.StatusOK, first.Code)
+		var page jobsResponse
+		decode(t, first, &page)
+		require.Len(t, page.Jobs, 2)
+		assert.Equal(t, int64(3), page.Jobs[0].ID)
+		assert.Equal(t, int64(2), page.Jobs[1].ID)
+		assert.Empty(t, page.Jobs[0].Content)
+		assert.NotEmpty(t, page.NextCursor)
+
+		// WHEN: Запрашиваем следующую страницу по курсору
+		second := get(s, "/jobs?limit=2&cursor="+page.NextCursor)
+
+		// THEN: Получена оставшаяся вакансия, курсора больше нет
+		var next jobsResponse
+		decode(t, second, &next)
+		require.Len(t, next.Jobs, 1)
+		assert.Equal(t, int64(1), next.Jobs[0].ID)
+		assert.Empty(t, next.NextCursor)
+	})
+
+	t.Run("фильтры", func(t *testing.T) {
+		// GIVEN: Вакансии по golang и java, одна из golang закрыта
+		s, repo := newTestServer(t)
+		repo.Jobs[2].Status = model.JobStatusClosed
+
+		// WHEN/THEN: Технология и статус по умолчанию (active)
+		var page jobsResponse
+		decode(t, get(s, "/jobs?technology=GoLang"), &page)
+		require.Len(t, page.Jobs, 1)
+		assert.Equal(t, int64(1), page.Jobs[0].ID)
+
+		// WHEN/THEN: Несколько статусов через запятую
+		decode(t, get(s, "/jobs?technology=golang&status=active,closed"), &page)
+		assert.Len(t, page.Jobs, 2)
+
+		// WHEN/THEN: Диапазон дат: from включительно, to не включительно
+		decode(t, get(s, "/jobs?from=2026-10-02&to=2026-10-03T12:00:00Z"), &page)
+		require.Len(t, page.Jobs, 1)
+		assert.Equal(t, int64(2), page.Jobs[0].ID)
+
+		// WHEN/THEN: Текстовый запрос
+		decode(t, get(s, "/jobs?q=JAVA"), &page)
+		require.Len(t, page.Jobs, 1)
+		assert.Equal(t, "java", page.Jobs[0].MainTechnology)
+	})
+
+	t.Run("некорректные параметры", func(t *testing.T) {
+		// GIVEN: Сервер API
+		s, _ := newTestServer(t)
+
+		for _, target := range []string{
+			"/jobs?limit=0",
+			"/jobs?from=yesterday",
+			"/jobs?status=spam",
+			"/jobs?cursor=not-a-cursor",
+		} {
+			// WHEN: Запрос с некорректным параметром
+			recorder := get(s, target)
+
+			// THEN: 400 с описанием ошибки
+			assert.Equal(t, http.StatusBadRequest, recorder.Code, target)
+			var response errorResponse
+			decode(t, recorder, &response)
+			assert.NotEmpty(t, response.Error, target)
+		}
+	})
+
+	t.Run("ошибка репозитория", func(t *testing.T) {
+		// GIVEN: Репозиторий возвращает ошибку
+		s, repo := newTestServer(t)
+		repo.ShouldError = true
+
+		// WHEN: Запрашиваем вакансии
+		recorder := get(s, "/jobs")
+
+		// THEN: 500 без подробностей ошибки
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		assert.NotContains(t, recorder.Body.String(), "mock")
+	})
+}
+
+func TestGetJob(t *testing.T) {
+	t.Run("вакансия с текстом", func(t *testing.T) {
+		// GIVEN: Сохраненная вакансия
+		s, repo := newTestServer(t)
+
+		// WHEN: Запрашиваем вакансию по slug
+		recorder := get(s, "/jobs/"+repo.Jobs[0].Slug)
+
+		// THEN: Вакансия отдана вместе с текстом, пустые списки - [], а не null
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/json; charset=utf-8", recorder.Header().Get("Content-Type"))
+		var job jobResponse
+		decode(t, recorder, &job)
+		assert.Equal(t, repo.Jobs[0].ContentPure, job.Text)
+		assert.Contains(t, recorder.Body.String(), `"tags":[]`)
+	})
+
+	t.Run("не найдена или скрыта", func(t *testing.T) {
+		// GIVEN: Вакансия, скрытая модератором
+		s, repo := newTestServer(t)
+		repo.Jobs[0].Status = model.JobStatusHidden
+
+		// WHEN/THEN: Скрытая и несуществующая вакансии не отдаются
+		assert.Equal(t, http.StatusNotFound, get(s, "/jobs/"+repo.Jobs[0].Slug).Code)
+		assert.Equal(t, http.StatusNotFound, get(s, "/jobs/missing").Code)
+	})
+}
+
+func TestETag(t *testing.T) {
+	// GIVEN: Ответ со списком вакансий и его ETag
+	s, repo := newTestServer(t)
+	first := get(s, "/jobs")
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// WHEN: Повторяем запрос с If-None-Match
+	cached := get(s, "/jobs", "If-None-Match", "W/"+etag)
+
+	// THEN: 304 без тела
+	assert.Equal(t, http.StatusNotModified, cached.Code)
+	assert.Empty(t, cached.Body.String())
+
+	// WHEN: Данные изменились
+	repo.Jobs[0].Title = "Новый заголовок"
+	changed := get(s, "/jobs", "If-None-Match", etag)
+
+	// THEN: Отдается новый ответ с другим ETag
+	assert.Equal(t, http.StatusOK, changed.Code)
+	assert.NotEqual(t, etag, changed.Header().Get("ETag"))
+}
+
+func TestListTechnologiesAndChannels(t *testing.T) {
+	// GIVEN: Технологии со счетчиками и канал
+	s, repo := newTestServer(t)
+	golang := test.CreateMockTechnology(1, "golang", 0, "go")
+	golang.Count = 2
+	java := test.CreateMockTechnology(2, "java", 1, "java")
+	java.Count = 5
+	repo.Technologies = []model.Technology{golang, java}
+	repo.TelegramChannels = []model.TelegramChannel{test.CreateMockTelegramChannel(1, "golang_jobs", 100, 50)}
+
+	// WHEN: Запрашиваем технологии и каналы
+	var technologies technologiesResponse
+	decode(t, get(s, "/technologies"), &technologies)
+	var channels channelsResponse
+	decode(t, get(s, "/channels"), &channels)
+
+	// THEN: Технологии отсортированы по количеству вакансий, каналы отданы с количеством постов
+	require.Len(t, technologies.Technologies, 2)
+	assert.Equal(t, "java", technologies.Technologies[0].Technology)
+	assert.Equal(t, int64(5), technologies.Technologies[0].Count)
+	require.Len(t, channels.Channels, 1)
+	assert.Equal(t, "golang_jobs", channels.Channels[0].Tag)
+	assert.Equal(t, int64(50), channels.Channels[0].PostsParsed)
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+)
+
+// handleListTechnologies отдает технологии по убыванию количества активных вакансий
+func (s *Server) handleListTechnologies(w http.ResponseWriter, r *http.Request) {
+	technologies, err := s.repository.GetTechnologies(r.Context())
+	if err != nil {
+		s.writeInternalError(w, r, err)
+		return
+	}
+
+	response := technologiesResponse{Technologies: make([]technologyResponse, 0, len(technologies))}
+	for _, tech := range technologies {
+		response.Technologies = append(response.Technologies, newTechnologyResponse(tech))
+	}
+
+	// Технологии приходят по sort_order; при равном количестве этот порядок сохраняется
+	slices.SortStableFunc(response.Technologies, func(a, b technologyResponse) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	s.writeJSON(w, r, response)
+}
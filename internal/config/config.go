@@ -0,0 +1,174 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/joho/godotenv"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/api"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/db"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/feed"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/logger"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/parser/telegram"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/jobs"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/scheduler"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/site"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFile - файл конфигурации, который читается из рабочего каталога, если путь не задан явно
+const DefaultFile = "config.yaml"
+
+// Config - конфигурация приложения. Значения собираются слоями:
+// значения по умолчанию, файл YAML, переменные окружения и флаги командной строки
+type Config struct {
+	API       api.Config       `yaml:"api"`
+	DB        DBConfig         `yaml:"db"`
+	Data      db.DataPaths     `yaml:"data"`
+	Feed      feed.Config      `yaml:"feed"`
+	Jobs      jobs.Config      `yaml:"jobs"`
+	Log       logger.Config    `yaml:"log"`
+	Scheduler scheduler.Config `yaml:"scheduler"`
+	Site      site.Config      `yaml:"site"`
+	Telegram  telegram.Config  `yaml:"telegram"`
+}
+
+// DBConfig задает подключение к PostgreSQL: либо строкой DSN, либо отдельными параметрами
+type DBConfig struct {
+	DSN      string `yaml:"dsn"`
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	Name     string `yaml:"name"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	SSLMode  string `yaml:"sslmode"`
+}
+
+// ConnString возвращает строку подключения: DSN, если он задан, иначе собирает ее из параметров
+func (c DBConfig) ConnString() string {
+	if c.DSN != "" {
+		return c.DSN
+	}
+
+	params := []string{}
+	if c.Host != "" {
+		params = append(params, fmt.Sprintf("host=%s", c.Host))
+	}
+	if c.Port != "" {
+		params = append(params, fmt.Sprintf("port=%s", c.Port))
+	}
+	if c.Name != "" {
+		params = append(params, fmt.Sprintf("dbname=%s", c.Name))
+	}
+	if c.User != "" {
+		params = append(params, fmt.Sprintf("user=%s", c.User))
+	}
+	if c.Password != "" {
+		params = append(params, fmt.Sprintf("password=%s", c.Password))
+	}
+	if c.SSLMode != "" {
+		params = append(params, fmt.Sprintf("sslmode=%s", c.SSLMode))
+	}
+
+	return strings.Join(params, " ")
+}
+
+// Default возвращает конфигурацию по умолчанию; относительные пути считаются от рабочего каталога
+func Default() Config {
+	return Config{
+		API:       api.DefaultConfig(),
+		Data:      db.DefaultDataPaths(),
+		Feed:      feed.DefaultConfig(),
+		Jobs:      jobs.DefaultConfig(),
+		Log:       logger.DefaultConfig(),
+		Scheduler: scheduler.DefaultConfig(),
+		Site:      site.DefaultConfig(),
+		Telegram:  telegram.DefaultConfig(),
+	}
+}
+
+// Load собирает конфигурацию из значений по умолчанию, файла и переменных окружения.
+// Перед чтением окружения загружается .env из рабочего каталога, если он есть.
+// Путь к файлу берется из path, затем из CONFIG_FILE; если оба пусты, читается DefaultFile при его наличии.
+// Относительные пути к данным и логам в файле считаются от каталога этого файла
+func Load(path string) (Config, error) {
+	op := "config.Load"
+
+	cfg := Default()
+
+	// Переменные окружения процесса имеют приоритет над .env
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return cfg, fmt.Errorf("%s: загрузка .env: %w", op, err)
+	}
+
+	if path == "" {
+		path = os.Getenv("CONFIG_FILE")
+	}
+
+	explicit := path != ""
+	if !explicit {
+		path = DefaultFile
+	}
+
+	err := cfg.loadFile(path)
+	switch {
+	case err == nil:
+		cfg.resolvePaths(filepath.Dir(path))
+	case !explicit && errors.Is(err, fs.ErrNotExist):
+		// Файл по умолчанию необязателен
+	default:
+		return cfg, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return cfg, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Ленты ссылаются на сайт со статическими страницами, если другой адрес не задан
+	if cfg.Feed.SiteURL == "" {
+		cfg.Feed.SiteURL = cfg.Site.BaseURL
+	}
+
+	return cfg, nil
+}
+
+// loadFile накладывает на конфигурацию значения из YAML-файла; неизвестные ключи считаются ошибкой
+func (c *Config) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("разбор %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// resolvePaths делает относительные пути к данным, лентам, сайту и логам относительными каталогу dir
+func (c *Config) resolvePaths(dir string) {
+	for _, path := range []*string{
+		&c.Data.TelegramChannels,
+		&c.Data.Technologies,
+		&c.Data.StopWords,
+		&c.Data.TagAliases,
+		&c.Feed.Dir,
+		&c.Site.Dir,
+		&c.Site.Templates,
+		&c.Log.Dir,
+	} {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(dir, *path)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/extract"
+)
+
+// isolate переводит тест во временный каталог и очищает переменные окружения конфигурации
+func isolate(t *testing.T) string {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	for _, key := range []string{
+		"CONFIG_FILE", "API_ADDR", "API_ADMIN_TOKEN", "DATABASE_DSN", "PG_HOST", "PG_PORT", "PG_DATABASE_NAME", "PG_USER", "PG_PASSWORD",
+		"DB_SSLMODE", "DATA_TELEGRAM_CHANNELS", "DATA_TECHNOLOGIES", "DATA_STOP_WORDS", "DATA_TAG_ALIASES",
+		"FEED_BASE_URL", "FEED_SITE_URL", "FEED_DIR", "SITE_BASE_URL", "SITE_JOB_URL", "SITE_DIR", "LOG_DIR", "LOG_LEVEL", "BETTERSTACK_KEY", "BETTERSTACK_URL", "SCHEDULE_JITTER",
+	} {
+		t.Setenv(key, "")
+	}
+
+	return dir
+}
+
+// writeFile создает файл с содержимым content и возвращает путь к нему
+func writeFile(t *testing.T, path, content string) string {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("значения по умолчанию без файла", func(t *testing.T) {
+		// GIVEN: Пустой рабочий каталог и DSN из окружения
+		isolate(t)
+		t.Setenv("DATABASE_DSN", "postgres://localhost/test")
+
+		// WHEN: Загружаем конфигурацию
+		cfg, err := Load("")
+
+		// THEN: Используются значения по умолчанию, конфигурация корректна
+		require.NoError(t, err)
+		assert.Equal(t, Default().Telegram, cfg.Telegram)
+		assert.Equal(t, "data/telegram_channels.txt", cfg.Data.TelegramChannels)
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("файл и относительные пути", func(t *testing.T) {
+		// GIVEN: Файл конфигурации во вложенном каталоге
+		dir := isolate(t)
+		path := writeFile(t, filepath.Join(dir, "conf", "scraper.yaml"), `
+db:
+  host: db.local
+  name: jobs
+data:
+  telegram_channels: channels.txt
+  stop_words: /etc/scraper/stop_words.txt
+telegram:
+  workers: 8
+  politeness_delay: 2s
+scheduler:
+  schedules:
+    telegram: "0 */2 * * *"
+`)
+
+		// WHEN: Загружаем конфигурацию по явному пути
+		cfg, err := Load(path)
+
+		// THEN: Значения из файла наложены на значения по умолчанию
+		require.NoError(t, err)
+		assert.Equal(t, "host=db.local dbname=jobs", cfg.DB.ConnString())
+		assert.Equal(t, 8, cfg.Telegram.Workers)
+		assert.Equal(t, 2*time.Second, cfg.Telegram.PolitenessDelay)
+		assert.Equal(t, 50, cfg.Telegram.MaxPages)
+		assert.Equal(t, "0 */2 * * *", cfg.Scheduler.ScheduleFor("telegram"))
+
+		// THEN: Относительные пути считаются от каталога файла, абсолютные не меняются
+		assert.Equal(t, filepath.Join(dir, "conf", "channels.txt"), cfg.Data.TelegramChannels)
+		assert.Equal(t, filepath.Join(dir, "conf", "data", "technologies.csv"), cfg.Data.Technologies)
+		assert.Equal(t, "/etc/scraper/stop_words.txt", cfg.Data.StopWords)
+		assert.Equal(t, filepath.Join(dir, "conf", "data", "tag_aliases.csv"), cfg.Data.TagAliases)
+		assert.Equal(t, filepath.Join(dir, "conf", "feeds"), cfg.Feed.Dir)
+		assert.Equal(t, filepath.Join(dir, "conf", "site"), cfg.Site.Dir)
+		assert.Empty(t, cfg.Site.Templates)
+		assert.Equal(t, filepath.Join(dir, "conf", "logs"), cfg.Log.Dir)
+	})
+
+	t.Run("переменные окружения важнее файла", func(t *testing.T) {
+		// GIVEN: config.yaml в рабочем каталоге и переменные окружения
+		isolate(t)
+		writeFile(t, DefaultFile, "db:\n  host: from-file\nlog:\n  level: warn\n")
+		t.Setenv("PG_HOST", "from-env")
+		t.Setenv("LOG_LEVEL", "error")
+		t.Setenv("SCHEDULE_JITTER", "30s")
+		t.Setenv("SCHEDULE_TELEGRAM", "15m")
+		t.Setenv("SITE_BASE_URL", "https://example.com")
+
+		// WHEN: Загружаем конфигурацию без явного пути
+		cfg, err := Load("")
+
+		// THEN: Переменные окружения переопределяют файл
+		require.NoError(t, err)
+		assert.Equal(t, "from-env", cfg.DB.Host)
+		assert.Equal(t, "error", cfg.Log.Level)
+		assert.Equal(t, 30*time.Second, cfg.Scheduler.Jitter)
+		assert.Equal(t, "15m", cfg.Scheduler.ScheduleFor("telegram"))
+
+		// THEN: Без feed.site_url ленты ссылаются на адрес сайта
+		assert.Equal(t, "https://example.com", cfg.Feed.SiteURL)
+	})
+
+	t.Run("флаги важнее окружения", func(t *testing.T) {
+		// GIVEN: DSN в окружении и флаги командной строки
+		isolate(t)
+		t.Setenv("DATABASE_DSN", "from-env")
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		flags := RegisterFlags(fs)
+		require.NoError(t, fs.Parse([]string{"-dsn", "from-flag", "-log-level", "debug", "-api-addr", ":9090"}))
+
+		// WHEN: Загружаем конфигурацию и применяем флаги
+		cfg, err := Load(flags.ConfigPath())
+		require.NoError(t, err)
+		flags.Apply(&cfg)
+
+		// THEN: Указанные флаги переопределяют значения, остальные не меняются
+		assert.Equal(t, "from-flag", cfg.DB.DSN)
+		assert.Equal(t, "debug", cfg.Log.Level)
+		assert.Equal(t, ":9090", cfg.API.Addr)
+		assert.Equal(t, "data/technologies.csv", cfg.Data.Technologies)
+	})
+
+	t.Run("ошибки загрузки", func(t *testing.T) {
+		// GIVEN: Отсутствующий файл, неизвестный ключ и некорректная длительность в окружении
+		dir := isolate(t)
+		unknown := writeFile(t, filepath.Join(dir, "unknown.yaml"), "telegram:\n  wokers: 8\n")
+
+		// WHEN/THEN: Явно указанный файл обязателен
+		_, err := Load(filepath.Join(dir, "missing.yaml"))
+		assert.Error(t, err)
+
+		// WHEN/THEN: Опечатка в ключе не проходит молча
+		_, err = Load(unknown)
+		assert.ErrorContains(t, err, "wokers")
+
+		// WHEN/THEN: Некорректная длительность в окружении
+		t.Setenv("SCHEDULE_JITTER", "soon")
+		_, err = Load("")
+		assert.ErrorContains(t, err, "SCHEDULE_JITTER")
+	})
+}
+
+func TestExampleConfig(t *testing.T) {
+	// GIVEN: Пример конфигурации из корня репозитория
+	path, err := filepath.Abs(filepath.Join("..", "..", "config.example.yaml"))
+	require.NoError(t, err)
+	isolate(t)
+
+	// WHEN: Загружаем пример
+	cfg, err := Load(path)
+
+	// THEN: Пример разбирается без ошибок и проходит проверку
+	require.NoError(t, err)
+	assert.NoError(t, cfg.Validate())
+
+	// THEN: Словари в примере совпадают со встроенными, иначе скопировавший пример их потеряет
+	assert.Equal(t, extract.DefaultSeniority(), cfg.Jobs.Seniority)
+	assert.Equal(t, extract.DefaultEmploymentTypes(), cfg.Jobs.EmploymentTypes)
+}
+
+func TestValidate(t *testing.T) {
+	// GIVEN: Конфигурация с несколькими ошибками
+	cfg := Default()
+	cfg.Telegram.Workers = 0
+	cfg.Log.Level = "verbose"
+	cfg.Scheduler.Schedules = map[string]string{"telegram": "every hour"}
+	cfg.Jobs.Location.DropWorkFormats = []string{"office", "offline"}
+	cfg.Jobs.ChannelHealth.MaxStopWordsShare = 1.5
+	cfg.Jobs.ChannelHealth.Disable = []string{"failing", "dead"}
+	cfg.API.MaxLimit = 10
+	cfg.API.AdminToken = "secret"
+	cfg.Feed.BaseURL = "example.com/feeds"
+	cfg.Feed.Tags = -1
+	cfg.Site.BaseURL = "https://"
+	cfg.Site.JobURL = "https://example.com/jobs/"
+
+	// WHEN: Проверяем конфигурацию
+	err := cfg.Validate()
+
+	// THEN: Возвращены все ошибки разом
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "db:")
+	assert.ErrorContains(t, err, "telegram.workers")
+	assert.ErrorContains(t, err, "log.level")
+	assert.ErrorContains(t, err, "scheduler.schedules.telegram")
+	assert.ErrorContains(t, err, `неизвестный формат "offline"`)
+	assert.NotContains(t, err.Error(), `"office"`)
+	assert.ErrorContains(t, err, "jobs.channel_health.max_stop_words_share")
+	assert.ErrorContains(t, err, `неизвестная проблема "dead"`)
+	assert.NotContains(t, err.Error(), `"failing"`)
+	assert.ErrorContains(t, err, "api.max_limit")
+	assert.ErrorContains(t, err, "api.admin_token")
+	assert.ErrorContains(t, err, "feed.base_url")
+	assert.ErrorContains(t, err, "feed.tags")
+	assert.ErrorContains(t, err, "site.base_url")
+	assert.ErrorContains(t, err, "site.job_url")
+}
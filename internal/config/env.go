@@ -0,0 +1,84 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// schedulePrefix - префикс переменных окружения с расписанием источника, например SCHEDULE_TELEGRAM
+const schedulePrefix = "SCHEDULE_"
+
+// applyEnv накладывает на конфигурацию значения из переменных окружения
+func (c *Config) applyEnv() error {
+	setString(&c.API.Addr, "API_ADDR")
+	setString(&c.API.AdminToken, "API_ADMIN_TOKEN")
+
+	setString(&c.DB.DSN, "DATABASE_DSN")
+	setString(&c.DB.Host, "PG_HOST")
+	setString(&c.DB.Port, "PG_PORT")
+	setString(&c.DB.Name, "PG_DATABASE_NAME")
+	setString(&c.DB.User, "PG_USER")
+	setString(&c.DB.Password, "PG_PASSWORD")
+	setString(&c.DB.SSLMode, "DB_SSLMODE")
+
+	setString(&c.Data.TelegramChannels, "DATA_TELEGRAM_CHANNELS")
+	setString(&c.Data.Technologies, "DATA_TECHNOLOGIES")
+	setString(&c.Data.StopWords, "DATA_STOP_WORDS")
+	setString(&c.Data.TagAliases, "DATA_TAG_ALIASES")
+
+	setString(&c.Feed.BaseURL, "FEED_BASE_URL")
+	setString(&c.Feed.SiteURL, "FEED_SITE_URL")
+	setString(&c.Feed.Dir, "FEED_DIR")
+
+	setString(&c.Site.BaseURL, "SITE_BASE_URL")
+	setString(&c.Site.JobURL, "SITE_JOB_URL")
+	setString(&c.Site.Dir, "SITE_DIR")
+
+	setString(&c.Log.Dir, "LOG_DIR")
+	setString(&c.Log.Level, "LOG_LEVEL")
+	setString(&c.Log.BetterStack.Token, "BETTERSTACK_KEY")
+	setString(&c.Log.BetterStack.URL, "BETTERSTACK_URL")
+
+	if err := setDuration(&c.Scheduler.Jitter, "SCHEDULE_JITTER"); err != nil {
+		return err
+	}
+
+	for _, env := range os.Environ() {
+		key, value, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(key, schedulePrefix) || key == "SCHEDULE_JITTER" || value == "" {
+			continue
+		}
+
+		if c.Scheduler.Schedules == nil {
+			c.Scheduler.Schedules = make(map[string]string)
+		}
+		c.Scheduler.Schedules[strings.ToLower(strings.TrimPrefix(key, schedulePrefix))] = value
+	}
+
+	return nil
+}
+
+// setString записывает значение переменной окружения key в target, если она задана
+func setString(target *string, key string) {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		*target = value
+	}
+}
+
+// setDuration записывает длительность из переменной окружения key в target, если она задана
+func setDuration(target *time.Duration, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("переменная %s: %w", key, err)
+	}
+
+	*target = duration
+	return nil
+}
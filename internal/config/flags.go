@@ -0,0 +1,63 @@
+package config
+
+import "flag"
+
+// Flags - флаги командной строки, которые переопределяют конфигурацию
+type Flags struct {
+	fs               *flag.FlagSet
+	configPath       string
+	apiAddr          string
+	dsn              string
+	telegramChannels string
+	technologies     string
+	stopWords        string
+	tagAliases       string
+	logDir           string
+	logLevel         string
+}
+
+// RegisterFlags регистрирует флаги конфигурации в наборе fs
+func RegisterFlags(fs *flag.FlagSet) *Flags {
+	f := &Flags{fs: fs}
+
+	fs.StringVar(&f.configPath, "config", "", "путь к YAML-файлу конфигурации (по умолчанию $CONFIG_FILE или "+DefaultFile+")")
+	fs.StringVar(&f.apiAddr, "api-addr", "", "адрес HTTP API, например :8080")
+	fs.StringVar(&f.dsn, "dsn", "", "строка подключения к PostgreSQL")
+	fs.StringVar(&f.telegramChannels, "channels-file", "", "файл со списком Telegram-каналов")
+	fs.StringVar(&f.technologies, "technologies-file", "", "CSV-файл с технологиями")
+	fs.StringVar(&f.stopWords, "stop-words-file", "", "файл со стоп-словами")
+	fs.StringVar(&f.tagAliases, "tag-aliases-file", "", "CSV-файл со связями хэштегов и технологий")
+	fs.StringVar(&f.logDir, "log-dir", "", "каталог для файла логов")
+	fs.StringVar(&f.logLevel, "log-level", "", "минимальный уровень логов: debug, info, warn, error")
+
+	return f
+}
+
+// ConfigPath возвращает путь к файлу конфигурации, заданный флагом -config
+func (f *Flags) ConfigPath() string {
+	return f.configPath
+}
+
+// Apply переопределяет конфигурацию флагами, явно указанными в командной строке
+func (f *Flags) Apply(c *Config) {
+	f.fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "api-addr":
+			c.API.Addr = f.apiAddr
+		case "dsn":
+			c.DB.DSN = f.dsn
+		case "channels-file":
+			c.Data.TelegramChannels = f.telegramChannels
+		case "technologies-file":
+			c.Data.Technologies = f.technologies
+		case "stop-words-file":
+			c.Data.StopWords = f.stopWords
+		case "tag-aliases-file":
+			c.Data.TagAliases = f.tagAliases
+		case "log-dir":
+			c.Log.Dir = f.logDir
+		case "log-level":
+			c.Log.Level = f.logLevel
+		}
+	})
+}
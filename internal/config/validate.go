@@ -0,0 +1,152 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/extract"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/scheduler"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zapcore"
+)
+
+// minAdminTokenLength - минимальная длина токена административного API
+const minAdminTokenLength = 16
+
+// Validate проверяет конфигурацию и возвращает все найденные ошибки разом
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.API.Addr != "", "api.addr: адрес не задан")
+	check(c.API.DefaultLimit >= 1, "api.default_limit: должен быть не меньше 1")
+	check(c.API.MaxLimit >= c.API.DefaultLimit, "api.max_limit: должен быть не меньше default_limit")
+	check(c.API.AdminToken == "" || len(c.API.AdminToken) >= minAdminTokenLength,
+		"api.admin_token: должен быть не короче %d символов", minAdminTokenLength)
+	check(c.API.ReadTimeout >= 0, "api.read_timeout: не может быть отрицательным")
+	check(c.API.WriteTimeout >= 0, "api.write_timeout: не может быть отрицательным")
+
+	check(c.DB.ConnString() != "", "db: не задана строка подключения (db.dsn или параметры подключения)")
+
+	check(c.Data.TelegramChannels != "", "data.telegram_channels: путь не задан")
+	check(c.Data.Technologies != "", "data.technologies: путь не задан")
+	check(c.Data.StopWords != "", "data.stop_words: путь не задан")
+	check(c.Data.TagAliases != "", "data.tag_aliases: путь не задан")
+
+	check(c.Feed.Title != "", "feed.title: заголовок не задан")
+	check(c.Feed.Limit >= 1, "feed.limit: должен быть не меньше 1")
+	check(c.Feed.Tags >= 0, "feed.tags: не может быть отрицательным")
+	check(c.Feed.Dir != "", "feed.dir: каталог не задан")
+	if c.Feed.BaseURL != "" {
+		if baseURL, err := url.Parse(c.Feed.BaseURL); err != nil || baseURL.Scheme == "" || baseURL.Host == "" {
+			errs = append(errs, fmt.Errorf("feed.base_url: некорректный адрес %q", c.Feed.BaseURL))
+		}
+	}
+	if c.Feed.SiteURL != "" {
+		if siteURL, err := url.Parse(c.Feed.SiteURL); err != nil || siteURL.Scheme == "" || siteURL.Host == "" {
+			errs = append(errs, fmt.Errorf("feed.site_url: некорректный адрес %q", c.Feed.SiteURL))
+		}
+	}
+
+	check(c.Jobs.Salary.BaseCurrency != "", "jobs.salary.base_currency: валюта не задана")
+	for currency, rate := range c.Jobs.Salary.Rates {
+		check(rate > 0, "jobs.salary.rates.%s: курс должен быть больше 0", currency)
+	}
+
+	if _, err := extract.NewDictionary(c.Jobs.Seniority); err != nil {
+		errs = append(errs, fmt.Errorf("jobs.seniority: %w", err))
+	}
+	if _, err := extract.NewDictionary(c.Jobs.EmploymentTypes); err != nil {
+		errs = append(errs, fmt.Errorf("jobs.employment_types: %w", err))
+	}
+	for _, phrase := range c.Jobs.ClosingPhrases {
+		if _, err := extract.CompileKeyword(phrase); err != nil {
+			errs = append(errs, fmt.Errorf("jobs.closing_phrases: %w", err))
+		}
+	}
+
+	for _, format := range c.Jobs.Location.DropWorkFormats {
+		check(slices.Contains([]string{model.WorkFormatRemote, model.WorkFormatHybrid, model.WorkFormatOffice}, format),
+			"jobs.location.drop_work_formats: неизвестный формат %q", format)
+	}
+
+	check(c.Jobs.Duplicates.Window >= 0, "jobs.duplicates.window: не может быть отрицательным")
+	check(c.Jobs.Duplicates.MaxDistance >= 0 && c.Jobs.Duplicates.MaxDistance <= 16,
+		"jobs.duplicates.max_distance: должен быть от 0 до 16")
+	check(c.Jobs.ExpireAfter >= 0, "jobs.expire_after: не может быть отрицательным")
+
+	health := c.Jobs.ChannelHealth
+	check(health.Window >= 0, "jobs.channel_health.window: не может быть отрицательным")
+	check(health.MinPosts >= 0, "jobs.channel_health.min_posts: не может быть отрицательным")
+	check(health.MaxConsecutiveFailures >= 0, "jobs.channel_health.max_consecutive_failures: не может быть отрицательным")
+	check(health.InactiveAfter >= 0, "jobs.channel_health.inactive_after: не может быть отрицательным")
+	check(health.MaxStopWordsShare >= 0 && health.MaxStopWordsShare <= 1,
+		"jobs.channel_health.max_stop_words_share: должна быть от 0 до 1")
+	check(health.MaxUnclassifiedShare >= 0 && health.MaxUnclassifiedShare <= 1,
+		"jobs.channel_health.max_unclassified_share: должна быть от 0 до 1")
+	for _, issue := range health.Disable {
+		check(slices.Contains(model.ChannelIssues, issue), "jobs.channel_health.disable: неизвестная проблема %q", issue)
+	}
+
+	check(c.Log.Dir != "", "log.dir: каталог не задан")
+	check(c.Log.File != "", "log.file: имя файла не задано")
+	if _, err := zapcore.ParseLevel(c.Log.Level); err != nil {
+		errs = append(errs, fmt.Errorf("log.level: %w", err))
+	}
+	if _, err := zapcore.ParseLevel(c.Log.ConsoleLevel); err != nil {
+		errs = append(errs, fmt.Errorf("log.console_level: %w", err))
+	}
+	if c.Log.BetterStack.Enabled() {
+		check(c.Log.BetterStack.BatchSize > 0, "log.betterstack.batch_size: должен быть больше 0")
+		check(c.Log.BetterStack.FlushInterval > 0, "log.betterstack.flush_interval: должен быть больше 0")
+	}
+
+	check(c.Site.Dir != "", "site.dir: каталог не задан")
+	check(c.Site.Title != "", "site.title: название не задано")
+	if c.Site.BaseURL != "" {
+		if baseURL, err := url.Parse(c.Site.BaseURL); err != nil || baseURL.Scheme == "" || baseURL.Host == "" {
+			errs = append(errs, fmt.Errorf("site.base_url: некорректный адрес %q", c.Site.BaseURL))
+		}
+	}
+	if c.Site.JobURL != "" {
+		jobURL, err := url.Parse(c.Site.JobURL)
+		if err != nil || jobURL.Scheme == "" || jobURL.Host == "" || !strings.Contains(c.Site.JobURL, "{slug}") {
+			errs = append(errs, fmt.Errorf("site.job_url: некорректный шаблон %q, нужен абсолютный адрес с {slug}", c.Site.JobURL))
+		}
+	}
+
+	t := c.Telegram
+	if baseURL, err := url.Parse(t.BaseURL); err != nil || baseURL.Scheme == "" || baseURL.Host == "" {
+		errs = append(errs, fmt.Errorf("telegram.base_url: некорректный адрес %q", t.BaseURL))
+	}
+	check(t.MaxPages >= 1, "telegram.max_pages: должен быть не меньше 1")
+	check(t.NewChannelMaxPages >= 1, "telegram.new_channel_max_pages: должен быть не меньше 1")
+	check(t.Workers >= 1, "telegram.workers: должен быть не меньше 1")
+	check(t.RequestsPerSecond >= 0, "telegram.requests_per_second: не может быть отрицательным")
+	check(t.PolitenessDelay >= 0, "telegram.politeness_delay: не может быть отрицательным")
+	check(t.RequestTimeout >= 0, "telegram.request_timeout: не может быть отрицательным")
+	check(t.MaxRetries >= 0, "telegram.max_retries: не может быть отрицательным")
+	check(t.RetryBaseDelay >= 0, "telegram.retry_base_delay: не может быть отрицательным")
+	check(t.MaxRetryDelay >= t.RetryBaseDelay, "telegram.max_retry_delay: должен быть не меньше retry_base_delay")
+	check(t.CircuitBreakerThreshold >= 0, "telegram.circuit_breaker_threshold: не может быть отрицательным")
+	check(t.RevisitWindow >= 0, "telegram.revisit_window: не может быть отрицательным")
+
+	check(c.Scheduler.Jitter >= 0, "scheduler.jitter: не может быть отрицательным")
+	if _, err := scheduler.ParseSchedule(c.Scheduler.DefaultSchedule); err != nil {
+		errs = append(errs, fmt.Errorf("scheduler.default_schedule: %w", err))
+	}
+	for name, spec := range c.Scheduler.Schedules {
+		if _, err := scheduler.ParseSchedule(spec); err != nil {
+			errs = append(errs, fmt.Errorf("scheduler.schedules.%s: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -7,34 +7,67 @@ import (
 	"go.uber.org/zap"
 )
 
-// PopulateDatabase загружает все необходимые данные в базу данных
-func PopulateDatabase(ctx context.Context, repo repository.JobsRepository, logger *zap.Logger) error {
+// DataPaths - пути к файлам с исходными данными для загрузки в БД
+type DataPaths struct {
+	TelegramChannels string `yaml:"telegram_channels"`
+	Technologies     string `yaml:"technologies"`
+	StopWords        string `yaml:"stop_words"`
+	TagAliases       string `yaml:"tag_aliases"`
+}
+
+// DefaultDataPaths возвращает пути к файлам данных относительно корня проекта
+func DefaultDataPaths() DataPaths {
+	return DataPaths{
+		TelegramChannels: "data/telegram_channels.txt",
+		Technologies:     "data/technologies.csv",
+		StopWords:        "data/stop_words.txt",
+		TagAliases:       "data/tag_aliases.csv",
+	}
+}
+
+// IsPopulated сообщает, что файлы данных уже загружались в БД. После первого импорта каналы, технологии
+// и стоп-слова правятся через административный API, и повторный импорт файлов вернул бы удаленные
+// записи и затер бы измененные ключевые слова и sort_order. Отметка об импорте хранится отдельно
+// от самих данных, поэтому удаление всех записей через API не делает БД снова пустой
+func IsPopulated(ctx context.Context, repo repository.JobsRepository) (bool, error) {
+	return repo.IsDataImported(ctx)
+}
+
+// PopulateDatabase загружает все необходимые данные в базу данных и отмечает импорт
+func PopulateDatabase(ctx context.Context, repo repository.JobsRepository, paths DataPaths, logger *zap.Logger) error {
 	// Импорт Telegram каналов
-	telegramChannelsPath := "../data/telegram_channels.txt"
-	if err := populateTelegramChannels(ctx, repo, telegramChannelsPath, logger); err != nil {
+	if err := ImportTelegramChannels(ctx, repo, paths.TelegramChannels, logger); err != nil {
 		return err
 	}
 
 	// Импорт технологий
-	technologiesPath := "../data/technologies.csv"
-	if err := populateTechnologies(ctx, repo, technologiesPath, logger); err != nil {
+	if err := ImportTechnologies(ctx, repo, paths.Technologies, logger); err != nil {
 		return err
 	}
 
 	// Импорт стоп-слов
-	stopWordsPath := "../data/stop_words.txt"
-	if err := populateStopWords(ctx, repo, stopWordsPath, logger); err != nil {
+	if err := ImportStopWords(ctx, repo, paths.StopWords, logger); err != nil {
+		return err
+	}
+
+	// Импорт связей хэштегов с технологиями, после технологий
+	if err := ImportTagAliases(ctx, repo, paths.TagAliases, logger); err != nil {
+		return err
+	}
+
+	if err := repo.SaveDataImport(ctx); err != nil {
+		logger.Error("Ошибка сохранения отметки об импорте данных", zap.Error(err))
 		return err
 	}
 
 	return nil
 }
 
-// populateTelegramChannels импортирует Telegram каналы из файла в базу данных
-func populateTelegramChannels(ctx context.Context, repo repository.JobsRepository, filePath string, logger *zap.Logger) error {
+// ImportTelegramChannels импортирует Telegram каналы из файла в базу данных
+func ImportTelegramChannels(ctx context.Context, repo repository.JobsRepository, filePath string, logger *zap.Logger) error {
 	logger.Info("Начинаем импорт Telegram каналов из файла", zap.String("filePath", filePath))
 
-	channels, err := repo.SaveChannels(filePath)
+	channels, err := repo.SaveChannels(ctx, filePath)
 	if err != nil {
 		logger.Error("Ошибка сохранения каналов", zap.Error(err))
 		return err
@@ -44,11 +77,11 @@ func populateTelegramChannels(ctx context.Context, repo repository.JobsRepositor
 	return nil
 }
 
-// populateTechnologies импортирует технологии из CSV файла в базу данных
-func populateTechnologies(ctx context.Context, repo repository.JobsRepository, filePath string, logger *zap.Logger) error {
+// ImportTechnologies импортирует технологии из CSV файла в базу данных
+func ImportTechnologies(ctx context.Context, repo repository.JobsRepository, filePath string, logger *zap.Logger) error {
 	logger.Info("Начинаем импорт технологий из файла", zap.String("filePath", filePath))
 
-	technologies, err := repo.SaveTechnologies(filePath)
+	technologies, err := repo.SaveTechnologies(ctx, filePath)
 	if err != nil {
 		logger.Error("Ошибка сохранения технологий", zap.Error(err))
 		return err
@@ -58,11 +91,11 @@ func populateTechnologies(ctx context.Context, repo repository.JobsRepository, f
 	return nil
 }
 
-// populateStopWords импортирует стоп-слова из файла в базу данных
-func populateStopWords(ctx context.Context, repo repository.JobsRepository, filePath string, logger *zap.Logger) error {
+// ImportStopWords импортирует стоп-слова из файла в базу данных
+func ImportStopWords(ctx context.Context, repo repository.JobsRepository, filePath string, logger *zap.Logger) error {
 	logger.Info("Начинаем импорт стоп-слов из файла", zap.String("filePath", filePath))
 
-	stopWords, err := repo.SaveStopWords(filePath)
+	stopWords, err := repo.SaveStopWords(ctx, filePath)
 	if err != nil {
 		logger.Error("Ошибка сохранения стоп-слов", zap.Error(err))
 		return err
@@ -71,3 +104,17 @@ func populateStopWords(ctx context.Context, repo repository.JobsRepository, file
 	logger.Info("Стоп-слова успешно сохранены", zap.Int("count", stopWords))
 	return nil
 }
+
+// ImportTagAliases импортирует связи хэштегов с технологиями из CSV файла в базу данных
+func ImportTagAliases(ctx context.Context, repo repository.JobsRepository, filePath string, logger *zap.Logger) error {
+	logger.Info("Начинаем импорт хэштегов технологий из файла", zap.String("filePath", filePath))
+
+	aliases, err := repo.SaveTagAliases(ctx, filePath)
+	if err != nil {
+		logger.Error("Ошибка сохранения хэштегов технологий", zap.Error(err))
+		return err
+	}
+
+	logger.Info("Хэштеги технологий успешно сохранены", zap.Int("count", aliases))
+	return nil
+}
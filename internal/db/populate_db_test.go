@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestIsPopulated проверяет отметку о загрузке файлов данных согласно шаблону GIVEN-WHEN-THEN
+func TestIsPopulated(t *testing.T) {
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+
+	t.Run("пустая БД", func(t *testing.T) {
+		// GIVEN: БД, в которую файлы еще не загружались
+		repo := test.NewMockRepository(logger)
+
+		// WHEN: Проверяем, заполнена ли БД
+		populated, err := IsPopulated(ctx, repo)
+
+		// THEN: БД считается пустой
+		require.NoError(t, err)
+		assert.False(t, populated)
+	})
+
+	t.Run("после импорта и удаления всех технологий", func(t *testing.T) {
+		// GIVEN: Файлы загружены, затем администратор удалил все технологии
+		repo := test.NewMockRepository(logger)
+		require.NoError(t, PopulateDatabase(ctx, repo, DefaultDataPaths(), logger))
+		repo.Technologies = nil
+
+		// WHEN: Проверяем, заполнена ли БД
+		populated, err := IsPopulated(ctx, repo)
+
+		// THEN: БД по-прежнему считается заполненной, повторного импорта не будет
+		require.NoError(t, err)
+		assert.True(t, populated)
+	})
+}
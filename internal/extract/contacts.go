@@ -0,0 +1,176 @@
+package extract
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// maxCompanyLength ограничивает длину названия компании, чтобы не сохранить вместо него абзац текста
+const maxCompanyLength = 100
+
+var (
+	// telegramUsernameRegexp - упоминание "@username": 5-32 символа, начинается с буквы.
+	// Символ перед "@" проверяется отдельно, чтобы не принять за упоминание часть email
+	telegramUsernameRegexp = regexp.MustCompile(`@([a-zA-Z][a-zA-Z0-9_]{4,31})`)
+	telegramNameRegexp     = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{4,31}$`)
+	emailRegexp            = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
+	// phoneRegexp - международный номер с "+" или российский мобильный, начинающийся с 8
+	phoneRegexp = regexp.MustCompile(`\+\d[\d\s\-()]{8,18}\d|(?:^|[^\d])(8[\s\-(]*9\d{2}[\s\-)]*\d{3}[\s\-]*\d{2}[\s\-]*\d{2})`)
+	urlRegexp   = regexp.MustCompile(`https?://[^\s<>"«»]+`)
+
+	// companyLineRegexp - строка вида "Компания: Яндекс", "🏢 Company — Acme"
+	companyLineRegexp = regexp.MustCompile(`(?im)^[^\p{L}\n]*(?:компания|company|работодатель|employer)\s*[:\-–—]\s*(.+)$`)
+	// companyQuotedRegexp - название в кавычках после слова "компания": "в компанию «Рога и копыта»"
+	companyQuotedRegexp = regexp.MustCompile(`(?i)(?:компани[яюиейь]|company)\s+[«"“]([^»"”\n]{2,100})[»"”]`)
+)
+
+// telegramServicePaths - первые сегменты ссылок t.me, которые не являются именами пользователей
+var telegramServicePaths = []string{"s", "joinchat", "share", "addstickers", "proxy", "socks", "iv", "c"}
+
+// Contacts извлекает компанию и контакты из HTML поста (ссылки) и его текста
+func Contacts(html, text string) model.Contacts {
+	var contacts model.Contacts
+
+	contacts.Company = company(text)
+
+	// Ссылки из HTML: Telegram оформляет упоминания и email как <a href>
+	if doc, err := goquery.NewDocumentFromReader(strings.NewReader(html)); err == nil {
+		doc.Find("a[href]").Each(func(_ int, a *goquery.Selection) {
+			href, _ := a.Attr("href")
+			addLink(&contacts, strings.TrimSpace(href))
+		})
+	}
+
+	// Контакты, написанные текстом без ссылок
+	for _, match := range telegramUsernameRegexp.FindAllStringSubmatchIndex(text, -1) {
+		if start := match[0]; start > 0 && isEmailRune(text[start-1]) {
+			continue
+		}
+		contacts.Telegram = appendUnique(contacts.Telegram, text[match[2]:match[3]])
+	}
+
+	for _, email := range emailRegexp.FindAllString(text, -1) {
+		contacts.Emails = appendUnique(contacts.Emails, email)
+	}
+
+	for _, match := range phoneRegexp.FindAllStringSubmatch(text, -1) {
+		phone := match[0]
+		if match[1] != "" {
+			phone = match[1]
+		}
+		if normalized, ok := normalizePhone(phone); ok {
+			contacts.Phones = appendUnique(contacts.Phones, normalized)
+		}
+	}
+
+	for _, link := range urlRegexp.FindAllString(text, -1) {
+		addLink(&contacts, strings.TrimRight(link, ".,;:!?)"))
+	}
+
+	return contacts
+}
+
+// addLink разбирает ссылку и добавляет ее в подходящее поле контактов
+func addLink(contacts *model.Contacts, href string) {
+	if email, ok := strings.CutPrefix(href, "mailto:"); ok {
+		email, _, _ = strings.Cut(email, "?")
+		if emailRegexp.MatchString(email) {
+			contacts.Emails = appendUnique(contacts.Emails, email)
+		}
+		return
+	}
+
+	if phone, ok := strings.CutPrefix(href, "tel:"); ok {
+		if normalized, ok := normalizePhone(phone); ok {
+			contacts.Phones = appendUnique(contacts.Phones, normalized)
+		}
+		return
+	}
+
+	u, err := url.Parse(href)
+	if err != nil {
+		return
+	}
+
+	switch {
+	case u.Scheme == "tg" && u.Host == "resolve":
+		if name := u.Query().Get("domain"); telegramNameRegexp.MatchString(name) {
+			contacts.Telegram = appendUnique(contacts.Telegram, name)
+		}
+	case u.Scheme != "http" && u.Scheme != "https":
+		// Относительные ссылки вида "?q=%23go" - хэштеги Telegram
+	case isTelegramHost(u.Host):
+		// Ссылка на пользователя или канал: t.me/<name>; ссылки на посты (t.me/<name>/<id>) пропускаем
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(segments) == 1 && telegramNameRegexp.MatchString(segments[0]) &&
+			!slices.Contains(telegramServicePaths, strings.ToLower(segments[0])) {
+			contacts.Telegram = appendUnique(contacts.Telegram, segments[0])
+		}
+	default:
+		contacts.ApplyURLs = appendUnique(contacts.ApplyURLs, href)
+	}
+}
+
+// company ищет название компании в тексте поста
+func company(text string) string {
+	var name string
+	if match := companyLineRegexp.FindStringSubmatch(text); match != nil {
+		name = match[1]
+	} else if match := companyQuotedRegexp.FindStringSubmatch(text); match != nil {
+		name = match[1]
+	}
+
+	name = strings.Trim(strings.TrimSpace(name), ".,;:!«»\"“”")
+	if name == "" || len([]rune(name)) > maxCompanyLength {
+		return ""
+	}
+
+	return name
+}
+
+// normalizePhone приводит телефон к виду +<цифры>; российский номер с 8 - к +7
+func normalizePhone(phone string) (string, bool) {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+
+	if len(digits) == 11 && digits[0] == '8' && !strings.HasPrefix(strings.TrimSpace(phone), "+") {
+		digits = "7" + digits[1:]
+	}
+
+	if len(digits) < 10 || len(digits) > 15 {
+		return "", false
+	}
+
+	return "+" + digits, true
+}
+
+// isTelegramHost сообщает, что ссылка ведет на Telegram
+func isTelegramHost(host string) bool {
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+	return host == "t.me" || host == "telegram.me" || host == "telegram.dog"
+}
+
+// isEmailRune сообщает, что символ может стоять перед "@" в email
+func isEmailRune(b byte) bool {
+	return b == '.' || b == '_' || b == '-' || b == '+' || b == '%' ||
+		(b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+// appendUnique добавляет значение, если его еще нет в списке (без учета регистра)
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,54 @@
+package extract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+func TestContacts(t *testing.T) {
+	t.Run("ссылки и текст поста", func(t *testing.T) {
+		// GIVEN: Пост с упоминанием рекрутера, email, телефоном и ссылкой на отклик
+		html := `<b>Go разработчик</b><br/>🏢 Компания: Acme Corp<br/>` +
+			`Писать <a href="https://t.me/hr_anna">@hr_anna</a> или <a href="mailto:jobs@acme.io">jobs@acme.io</a><br/>` +
+			`<a href="https://acme.io/careers/42">Откликнуться</a> <a href="?q=%23golang">#golang</a> ` +
+			`<a href="https://t.me/golang_jobs/123">пост</a>`
+		text := "Go разработчик\n🏢 Компания: Acme Corp\nПисать @hr_anna или jobs@acme.io\n" +
+			"Тел.: 8 (912) 345-67-89, https://acme.io/careers/42\nОткликнуться #golang пост"
+
+		// WHEN: Извлекаем контакты
+		contacts := Contacts(html, text)
+
+		// THEN: Найдены все контакты без дублей, ссылки на Telegram-посты и хэштеги пропущены
+		assert.Equal(t, model.Contacts{
+			Company:   "Acme Corp",
+			Telegram:  []string{"hr_anna"},
+			Emails:    []string{"jobs@acme.io"},
+			Phones:    []string{"+79123456789"},
+			ApplyURLs: []string{"https://acme.io/careers/42"},
+		}, contacts)
+	})
+
+	t.Run("контакты только в тексте", func(t *testing.T) {
+		// GIVEN: Пост без ссылок
+		text := "Вакансия в компанию «Рога и копыта». Резюме: @Recruiter_Bob, +44 20 7946 0958"
+
+		// WHEN: Извлекаем контакты
+		contacts := Contacts(text, text)
+
+		// THEN: Найдены компания в кавычках, имя пользователя и международный телефон
+		assert.Equal(t, "Рога и копыта", contacts.Company)
+		assert.Equal(t, []string{"Recruiter_Bob"}, contacts.Telegram)
+		assert.Equal(t, []string{"+442079460958"}, contacts.Phones)
+		assert.Empty(t, contacts.Emails)
+	})
+
+	t.Run("зарплата не считается телефоном", func(t *testing.T) {
+		// WHEN: Извлекаем контакты из текста с зарплатой
+		contacts := Contacts("", "ЗП 250 000 - 300 000 руб, опыт 3+ года")
+
+		// THEN: Телефоны не найдены
+		assert.Empty(t, contacts.Phones)
+	})
+}
@@ -0,0 +1,110 @@
+package extract
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DictionaryEntry - значение словаря и ключевые слова, по которым оно определяется
+type DictionaryEntry struct {
+	Value    string   `yaml:"value"`
+	Keywords []string `yaml:"keywords"`
+}
+
+// Dictionary определяет значения (грейд, тип занятости и т.п.) по ключевым словам
+type Dictionary struct {
+	entries []dictionaryEntry
+}
+
+type dictionaryEntry struct {
+	value    string
+	keywords []Keyword
+	negative []Keyword
+}
+
+// NewDictionary компилирует ключевые слова словаря. Некорректные ключевые слова пропускаются,
+// а их ошибки возвращаются вместе со словарем из остальных
+func NewDictionary(entries []DictionaryEntry) (*Dictionary, error) {
+	d := &Dictionary{entries: make([]dictionaryEntry, 0, len(entries))}
+
+	var errs []error
+	for _, entry := range entries {
+		compiled := dictionaryEntry{value: entry.Value}
+		if entry.Value == "" {
+			errs = append(errs, fmt.Errorf("пустое значение словаря"))
+			continue
+		}
+
+		for _, raw := range entry.Keywords {
+			keyword, err := CompileKeyword(raw)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", entry.Value, err))
+				continue
+			}
+
+			if keyword.Negative() {
+				compiled.negative = append(compiled.negative, keyword)
+			} else {
+				compiled.keywords = append(compiled.keywords, keyword)
+			}
+		}
+
+		d.entries = append(d.entries, compiled)
+	}
+
+	return d, errors.Join(errs...)
+}
+
+// Match возвращает значения словаря, ключевые слова которых встречаются в тексте, в порядке словаря
+func (d *Dictionary) Match(text string) []string {
+	var found []string
+
+entries:
+	for _, entry := range d.entries {
+		for _, keyword := range entry.negative {
+			if keyword.Count(text) > 0 {
+				continue entries
+			}
+		}
+
+		for _, keyword := range entry.keywords {
+			if keyword.Count(text) > 0 {
+				found = append(found, entry.value)
+				break
+			}
+		}
+	}
+
+	return found
+}
+
+// DefaultSeniority возвращает словарь грейдов по умолчанию
+func DefaultSeniority() []DictionaryEntry {
+	return []DictionaryEntry{
+		{Value: "intern", Keywords: []string{"intern", "internship", "trainee", "стажер*", "стажёр*", "стажировк*"}},
+		{Value: "junior", Keywords: []string{"junior*", "jun", "джун*", "младш*"}},
+		{Value: "middle", Keywords: []string{"middle*", "mid", "мидл*", "миддл*"}},
+		{Value: "senior", Keywords: []string{"senior*", "sr", "сеньор*", "синьор*", `re:старш\p{L}* (?:разработчик|инженер|программист|специалист|аналитик|тестировщик)`}},
+		{Value: "lead", Keywords: []string{"lead", "teamlead*", "team lead*", "techlead*", "tech lead*", "head of", "тимлид*", "техлид*", "ведущ*"}},
+	}
+}
+
+// DefaultEmploymentTypes возвращает словарь типов занятости по умолчанию
+func DefaultEmploymentTypes() []DictionaryEntry {
+	return []DictionaryEntry{
+		{Value: "full_time", Keywords: []string{"full-time", "full time", "fulltime", `re:(?:^|[^\p{L}])полн\p{L}* (?:рабоч\p{L}* )?(?:занятост|день)`}},
+		{Value: "part_time", Keywords: []string{"part-time", "part time", "parttime", `re:(?:частичн|неполн)\p{L}* (?:рабоч\p{L}* )?(?:занятост|день)`}},
+		{Value: "contract", Keywords: []string{"contract*", "b2b", "гпх", "по договору", "контракт*", "самозанят*"}},
+		{Value: "freelance", Keywords: []string{"freelance*", "фриланс*", `re:проектн\p{L}* работ`, `re:разов\p{L}* (?:задач|проект)`}},
+	}
+}
+
+// DefaultClosingPhrases возвращает фразы по умолчанию, которыми рекрутеры помечают закрытые вакансии
+func DefaultClosingPhrases() []string {
+	return []string{
+		`re:(?:ваканси[яи]|позици[яи])\s+(?:уже\s+)?(?:закрыт|неактуальн|не\s+актуальн)`,
+		`re:(?:ваканси[яи]|позици[яи])\s+больше\s+не\s+актуальн`,
+		"неактуально", "не актуально", "набор закрыт", "подбор закрыт",
+		"position closed", "position filled", "vacancy closed", "job closed", "no longer available",
+	}
+}
@@ -0,0 +1,87 @@
+package extract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultSeniority(t *testing.T) {
+	dictionary, err := NewDictionary(DefaultSeniority())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{name: "Несколько грейдов", text: "Ищем Middle+/Senior Go разработчика", expected: []string{"middle", "senior"}},
+		{name: "По-русски", text: "Вакансия для джуна, возможна стажировка", expected: []string{"intern", "junior"}},
+		{name: "Тимлид", text: "Нужен тимлид команды платежей", expected: []string{"lead"}},
+		{name: "Старший разработчик", text: "Старший разработчик Java", expected: []string{"senior"}},
+		{name: "Возраст не грейд", text: "Кандидат старше 18 лет, знание international стандартов", expected: nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, dictionary.Match(tc.text))
+		})
+	}
+}
+
+func TestDefaultEmploymentTypes(t *testing.T) {
+	dictionary, err := NewDictionary(DefaultEmploymentTypes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{name: "Полная занятость", text: "Полная занятость, удаленно", expected: []string{"full_time"}},
+		{name: "Неполная занятость не полная", text: "Неполная занятость, 20 часов в неделю", expected: []string{"part_time"}},
+		{name: "Контракт", text: "Full-time, B2B contract", expected: []string{"full_time", "contract"}},
+		{name: "Фриланс", text: "Разовый проект на фрилансе", expected: []string{"freelance"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, dictionary.Match(tc.text))
+		})
+	}
+}
+
+func TestNewDictionaryInvalidKeyword(t *testing.T) {
+	// GIVEN: Словарь с некорректным ключевым словом
+	entries := []DictionaryEntry{{Value: "senior", Keywords: []string{"re:(senior", "сеньор*"}}}
+
+	// WHEN: Компилируем словарь
+	dictionary, err := NewDictionary(entries)
+
+	// THEN: Возвращается ошибка, но корректные ключевые слова работают
+	assert.Error(t, err)
+	assert.Equal(t, []string{"senior"}, dictionary.Match("Сеньор разработчик"))
+}
+
+func TestDefaultClosingPhrases(t *testing.T) {
+	dictionary, err := NewDictionary([]DictionaryEntry{{Value: "closed", Keywords: DefaultClosingPhrases()}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{name: "Пометка в начале поста", text: "ВАКАНСИЯ ЗАКРЫТА\nGo разработчик в финтех", expected: []string{"closed"}},
+		{name: "Неактуально", text: "UPD: неактуально", expected: []string{"closed"}},
+		{name: "По-английски", text: "Position filled, thanks everyone", expected: []string{"closed"}},
+		{name: "Открытая вакансия", text: "Вакансия открыта, закрытое акционерное общество", expected: nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, dictionary.Match(tc.text))
+		})
+	}
+}
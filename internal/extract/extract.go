@@ -0,0 +1,46 @@
+// Package extract извлекает структурированные данные (зарплату и т.п.) из текста вакансий
+package extract
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// wordPattern оборачивает список альтернатив в регулярное выражение, совпадающее только
+// с целыми словами без учета регистра. Граница \b в RE2 работает только с ASCII, поэтому
+// границы задаются явно через классы букв и цифр. Точка перед словом тоже не считается границей,
+// чтобы ".NET" не совпадал с "net"
+func wordPattern(alternatives string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}_.])(?:` + alternatives + `)(?:$|[^\p{L}\p{N}_])`)
+}
+
+// isWordRune сообщает, является ли символ частью слова (буква, цифра или подчеркивание)
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// lineBounds возвращает границы строки текста, содержащей позицию pos
+func lineBounds(text string, pos int) (int, int) {
+	start := strings.LastIndexByte(text[:pos], '\n') + 1
+
+	end := strings.IndexByte(text[pos:], '\n')
+	if end < 0 {
+		return start, len(text)
+	}
+
+	return start, pos + end
+}
+
+// prevRune возвращает символ перед позицией pos или utf8.RuneError в начале текста
+func prevRune(text string, pos int) rune {
+	r, _ := utf8.DecodeLastRuneInString(text[:pos])
+	return r
+}
+
+// nextRune возвращает символ на позиции pos или utf8.RuneError в конце текста
+func nextRune(text string, pos int) rune {
+	r, _ := utf8.DecodeRuneInString(text[pos:])
+	return r
+}
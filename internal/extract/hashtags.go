@@ -0,0 +1,65 @@
+package extract
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// hashtagRegexp - хэштег: "#" и слово из букв, цифр и подчеркиваний
+var hashtagRegexp = regexp.MustCompile(`#([\p{L}\p{N}_]{2,64})`)
+
+// Hashtags возвращает хэштеги поста в нижнем регистре без "#", без повторов, в порядке появления.
+// Telegram оформляет хэштеги ссылками вида <a href="?q=%23golang">; кроме них учитываются
+// хэштеги, написанные текстом. Хэштеги только из цифр ("#1") не учитываются
+func Hashtags(html string) []string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return nil
+	}
+
+	var tags []string
+	add := func(tag string) {
+		tag = strings.ToLower(strings.TrimPrefix(tag, "#"))
+		if !strings.ContainsFunc(tag, unicode.IsLetter) {
+			return
+		}
+		for _, t := range tags {
+			if t == tag {
+				return
+			}
+		}
+		tags = append(tags, tag)
+	}
+
+	doc.Find("a[href]").Each(func(_ int, a *goquery.Selection) {
+		href, _ := a.Attr("href")
+		query, ok := strings.CutPrefix(href, "?")
+		if !ok {
+			return
+		}
+
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return
+		}
+
+		if q := values.Get("q"); hashtagRegexp.MatchString(q) {
+			add(hashtagRegexp.FindStringSubmatch(q)[1])
+		}
+	})
+
+	text := doc.Text()
+	for _, match := range hashtagRegexp.FindAllStringSubmatchIndex(text, -1) {
+		// "#" внутри слова (C#, F#) - не хэштег
+		if match[0] > 0 && isWordRune(prevRune(text, match[0])) {
+			continue
+		}
+		add(text[match[2]:match[3]])
+	}
+
+	return tags
+}
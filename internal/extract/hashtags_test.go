@@ -0,0 +1,42 @@
+package extract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashtags(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected []string
+	}{
+		{
+			name:     "Ссылки Telegram на поиск по хэштегу",
+			html:     `<a href="?q=%23golang">#golang</a> <a href="?q=%23Remote">#Remote</a>`,
+			expected: []string{"golang", "remote"},
+		},
+		{
+			name:     "Хэштеги текстом и кириллицей",
+			html:     `<p>Ищем разработчика #вакансия #senior_go</p>`,
+			expected: []string{"вакансия", "senior_go"},
+		},
+		{
+			name:     "Повторы не дублируются",
+			html:     `<a href="?q=%23go">#go</a> #Go #go`,
+			expected: []string{"go"},
+		},
+		{
+			name:     "Номера, C# и ссылки с якорем не являются хэштегами",
+			html:     `<p>Вакансия #1 на C# и F#, подробнее <a href="https://example.com/#apply">тут</a></p>`,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Hashtags(tc.html))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package extract
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Синтаксис ключевых слов (столбцы keyword* в technologies.csv, словари грейдов и типов занятости):
+//
+//	go          - целое слово, без учета регистра: совпадает с "Go-разработчик", но не с "google"
+//	аналитик*   - "*" в конце или в начале разрешает продолжение слова: "аналитика", "аналитиков"
+//	re:\bk8s\b  - регулярное выражение (синтаксис RE2), без учета регистра
+//	!google go  - отрицательное ключевое слово: если оно найдено, технология не присваивается.
+//	              Префикс "!" сочетается с остальными формами, например "!re:..."
+const (
+	negativePrefix = "!"
+	regexPrefix    = "re:"
+	wildcard       = "*"
+)
+
+// Keyword - скомпилированное ключевое слово
+type Keyword struct {
+	re *regexp.Regexp
+	// checkStart/checkEnd - нужно ли проверять границу слова перед совпадением и после него
+	checkStart bool
+	checkEnd   bool
+	negative   bool
+}
+
+// CompileKeyword разбирает ключевое слово
+func CompileKeyword(raw string) (Keyword, error) {
+	var m Keyword
+
+	keyword := strings.TrimSpace(raw)
+	if rest, ok := strings.CutPrefix(keyword, negativePrefix); ok {
+		m.negative = true
+		keyword = strings.TrimSpace(rest)
+	}
+
+	if pattern, ok := strings.CutPrefix(keyword, regexPrefix); ok {
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return m, fmt.Errorf("ключевое слово %q: %w", raw, err)
+		}
+		m.re = re
+		return m, nil
+	}
+
+	prefixWildcard := strings.HasPrefix(keyword, wildcard)
+	suffixWildcard := strings.HasSuffix(keyword, wildcard)
+	keyword = strings.Trim(keyword, wildcard)
+	if keyword == "" {
+		return m, fmt.Errorf("ключевое слово %q: пустое", raw)
+	}
+
+	m.re = regexp.MustCompile("(?i)" + regexp.QuoteMeta(keyword))
+
+	// Границу проверяем только со стороны буквы или цифры: "c++" и ".net" ограничены сами по себе
+	first, _ := utf8.DecodeRuneInString(keyword)
+	last, _ := utf8.DecodeLastRuneInString(keyword)
+	m.checkStart = !prefixWildcard && isWordRune(first)
+	m.checkEnd = !suffixWildcard && isWordRune(last)
+
+	return m, nil
+}
+
+// Negative сообщает, что ключевое слово отрицательное (с префиксом "!")
+func (m Keyword) Negative() bool {
+	return m.negative
+}
+
+// Count возвращает количество совпадений ключевого слова в тексте
+func (m Keyword) Count(text string) int {
+	if text == "" {
+		return 0
+	}
+
+	n := 0
+	for _, loc := range m.re.FindAllStringIndex(text, -1) {
+		if m.checkStart {
+			if r, _ := utf8.DecodeLastRuneInString(text[:loc[0]]); isWordRune(r) {
+				continue
+			}
+		}
+		if m.checkEnd {
+			if r, _ := utf8.DecodeRuneInString(text[loc[1]:]); isWordRune(r) {
+				continue
+			}
+		}
+		n++
+	}
+
+	return n
+}
@@ -0,0 +1,42 @@
+package extract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyword(t *testing.T) {
+	tests := []struct {
+		name     string
+		keyword  string
+		text     string
+		expected int
+		negative bool
+	}{
+		{name: "Целое слово", keyword: "go", text: "Go-разработчик, google, go", expected: 2},
+		{name: "Окончание по звездочке", keyword: "джун*", text: "Джуниор или джун", expected: 2},
+		{name: "Начало по звездочке", keyword: "*sql", text: "PostgreSQL, MySQL, SQL", expected: 3},
+		{name: "Спецсимволы на границе", keyword: "c++", text: "C++/Qt", expected: 1},
+		{name: "Регулярное выражение", keyword: `re:\bk8s\b`, text: "K8s", expected: 1},
+		{name: "Отрицательное ключевое слово", keyword: "!google", text: "Google", expected: 1, negative: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			keyword, err := CompileKeyword(tc.keyword)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, keyword.Count(tc.text))
+			assert.Equal(t, tc.negative, keyword.Negative())
+		})
+	}
+
+	t.Run("некорректные ключевые слова", func(t *testing.T) {
+		_, err := CompileKeyword("re:(go")
+		assert.Error(t, err)
+
+		_, err = CompileKeyword("*")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,197 @@
+package extract
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+var (
+	remoteRegexp = wordPattern(`удал[её]нн?\p{L}*|дистанц\p{L}*|remote|full[- ]remote|из любой (?:точки|страны)|work from home|wfh`)
+	hybridRegexp = wordPattern(`гибрид\p{L}*|hybrid|частично удал[её]нн?\p{L}*`)
+	// Слово office без уточнений не используем: оно встречается в "MS Office"
+	officeRegexp     = wordPattern(`офис\p{L}*|on[- ]?site|in[- ]office|office[- ]based|in the office`)
+	relocationRegexp = wordPattern(`релокац\p{L}*|relocation|relocate|переезд\p{L}*`)
+)
+
+// locationName сопоставляет название страны или города (корень слова с окончаниями) с нормализованным значением
+type locationName struct {
+	re    *regexp.Regexp
+	value string
+}
+
+var countries = []locationName{
+	{wordPattern(`рф|росси[яиюей]|russia|ru`), "RU"},
+	{wordPattern(`рб|беларус\p{L}*|белорусси\p{L}*|belarus`), "BY"},
+	{wordPattern(`казахстан\p{L}*|kazakhstan|kz`), "KZ"},
+	{wordPattern(`украин\p{L}*|ukraine`), "UA"},
+	{wordPattern(`грузи[яиюей]|georgia`), "GE"},
+	{wordPattern(`армени[яиюей]|armenia`), "AM"},
+	{wordPattern(`серби[яиюей]|serbia`), "RS"},
+	{wordPattern(`кипр\p{L}*|cyprus`), "CY"},
+	{wordPattern(`турци[яиюей]|turkey|türkiye`), "TR"},
+	{wordPattern(`узбекистан\p{L}*|uzbekistan`), "UZ"},
+	{wordPattern(`кыргызстан\p{L}*|киргизи[яиюей]|kyrgyzstan`), "KG"},
+	{wordPattern(`польш[аеиу]|poland`), "PL"},
+	{wordPattern(`германи[яиюей]|germany`), "DE"},
+	{wordPattern(`оаэ|uae|emirates`), "AE"},
+	{wordPattern(`сша|usa|united states`), "US"},
+	{wordPattern(`евросоюз\p{L}*|европ[аеуы]|eu|europe`), "EU"},
+}
+
+var cities = []locationName{
+	{wordPattern(`москв[аеуы]|moscow`), "Москва"},
+	{wordPattern(`санкт-петербург\p{L}*|петербург\p{L}*|спб|питер\p{L}*|saint petersburg|st\.? petersburg`), "Санкт-Петербург"},
+	{wordPattern(`новосибирск\p{L}*|novosibirsk`), "Новосибирск"},
+	{wordPattern(`екатеринбург\p{L}*|yekaterinburg`), "Екатеринбург"},
+	{wordPattern(`казан[ьи]|kazan`), "Казань"},
+	{wordPattern(`нижн\p{L}* новгород\p{L}*|nizhny novgorod`), "Нижний Новгород"},
+	{wordPattern(`минск\p{L}*|minsk`), "Минск"},
+	{wordPattern(`алмат[ыае]|almaty`), "Алматы"},
+	{wordPattern(`астан[аеуы]|astana`), "Астана"},
+	{wordPattern(`ташкент\p{L}*|tashkent`), "Ташкент"},
+	{wordPattern(`тбилиси|tbilisi`), "Тбилиси"},
+	{wordPattern(`ереван\p{L}*|yerevan`), "Ереван"},
+	{wordPattern(`белград\p{L}*|belgrade`), "Белград"},
+	{wordPattern(`лимассол\p{L}*|limassol`), "Лимассол"},
+	{wordPattern(`варшав\p{L}*|warsaw`), "Варшава"},
+	{wordPattern(`берлин\p{L}*|berlin`), "Берлин"},
+	{wordPattern(`дуба[йея]|dubai`), "Дубай"},
+}
+
+const (
+	timezoneOffsetPattern = `[+\-−]\s?\d{1,2}(?::\d{2})?`
+	timezoneZonePattern   = `(?:^|[^\p{L}])(?P<zone>utc|gmt|msk|мск)`
+	// mskOffset - смещение московского времени от UTC в минутах
+	mskOffset = 3 * 60
+)
+
+var (
+	// timezoneDeltaRegexp - пояс с допуском: "UTC+3 ±2", "МСК +-3"
+	timezoneDeltaRegexp = regexp.MustCompile(`(?i)` + timezoneZonePattern + `\s?(?P<offset>` + timezoneOffsetPattern + `)?\s?(?:±|\+-|\+/-)\s?(?P<delta>\d{1,2})`)
+	// timezoneSpanRegexp - диапазон поясов: "UTC+1 - UTC+5", "GMT+2..+4"
+	timezoneSpanRegexp = regexp.MustCompile(`(?i)` + timezoneZonePattern + `\s?(?P<from>` + timezoneOffsetPattern + `)\s?(?:-|–|—|\.\.|до|to)\s?(?:utc|gmt)?\s?(?P<to>` + timezoneOffsetPattern + `)`)
+	// timezoneExactRegexp - один пояс: "UTC+3", "GMT-5"
+	timezoneExactRegexp = regexp.MustCompile(`(?i)` + timezoneZonePattern + `\s?(?P<offset>` + timezoneOffsetPattern + `)`)
+	// timezoneMoscowRegexp - работа по московскому времени без указания смещения
+	timezoneMoscowRegexp = wordPattern(`по мск|по москве|по московскому времени|msk timezone|moscow time`)
+)
+
+// Location определяет формат работы, упомянутые страны и города, релокацию и ограничения по часовому поясу
+func Location(text string) model.Location {
+	var location model.Location
+
+	remote := remoteRegexp.MatchString(text)
+	office := officeRegexp.MatchString(text)
+
+	switch {
+	case hybridRegexp.MatchString(text):
+		location.WorkFormat = model.WorkFormatHybrid
+	case remote && office:
+		// "удаленно или в офисе" - кандидат может выбрать, вакансия не только офисная
+		location.WorkFormat = model.WorkFormatHybrid
+	case remote:
+		location.WorkFormat = model.WorkFormatRemote
+	case office:
+		location.WorkFormat = model.WorkFormatOffice
+	}
+
+	location.Relocation = relocationRegexp.MatchString(text)
+	location.Countries = findLocationNames(text, countries)
+	location.Cities = findLocationNames(text, cities)
+	location.TimezoneMin, location.TimezoneMax = timezoneRange(text)
+
+	return location
+}
+
+// findLocationNames возвращает нормализованные названия, встречающиеся в тексте, в порядке словаря
+func findLocationNames(text string, names []locationName) []string {
+	var found []string
+	for _, name := range names {
+		if name.re.MatchString(text) {
+			found = append(found, name.value)
+		}
+	}
+	return found
+}
+
+// timezoneRange возвращает допустимый диапазон часовых поясов в минутах от UTC
+func timezoneRange(text string) (*int, *int) {
+	if m := submatches(timezoneDeltaRegexp, text); m != nil {
+		center := zoneOffset(m["zone"]) + parseOffset(m["offset"])
+		delta, _ := strconv.Atoi(m["delta"])
+		return intPtr(center - delta*60), intPtr(center + delta*60)
+	}
+
+	if m := submatches(timezoneSpanRegexp, text); m != nil {
+		base := zoneOffset(m["zone"])
+		from, to := base+parseOffset(m["from"]), base+parseOffset(m["to"])
+		if from > to {
+			from, to = to, from
+		}
+		return intPtr(from), intPtr(to)
+	}
+
+	if m := submatches(timezoneExactRegexp, text); m != nil {
+		offset := zoneOffset(m["zone"]) + parseOffset(m["offset"])
+		return intPtr(offset), intPtr(offset)
+	}
+
+	if timezoneMoscowRegexp.MatchString(text) {
+		return intPtr(mskOffset), intPtr(mskOffset)
+	}
+
+	return nil, nil
+}
+
+// submatches возвращает именованные группы первого совпадения или nil
+func submatches(re *regexp.Regexp, text string) map[string]string {
+	match := re.FindStringSubmatch(text)
+	if match == nil {
+		return nil
+	}
+
+	result := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			result[name] = match[i]
+		}
+	}
+	return result
+}
+
+// zoneOffset возвращает смещение зоны от UTC в минутах
+func zoneOffset(zone string) int {
+	switch strings.ToLower(zone) {
+	case "msk", "мск":
+		return mskOffset
+	default:
+		return 0
+	}
+}
+
+// parseOffset разбирает смещение вида "+3", "-05:30", "−2" в минуты
+func parseOffset(offset string) int {
+	offset = strings.ReplaceAll(strings.ReplaceAll(offset, " ", ""), "−", "-")
+	if offset == "" {
+		return 0
+	}
+
+	sign := 1
+	if offset[0] == '-' {
+		sign = -1
+	}
+	offset = offset[1:]
+
+	hours, minutes, _ := strings.Cut(offset, ":")
+	h, _ := strconv.Atoi(hours)
+	m, _ := strconv.Atoi(minutes)
+
+	return sign * (h*60 + m)
+}
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -0,0 +1,75 @@
+package extract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+func TestLocationWorkFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "Удаленка", text: "Формат работы: удалённо", expected: model.WorkFormatRemote},
+		{name: "Remote", text: "Full remote, flexible hours", expected: model.WorkFormatRemote},
+		{name: "Гибрид", text: "Гибридный формат, 2 дня в офисе", expected: model.WorkFormatHybrid},
+		{name: "Удаленно или офис", text: "Можно работать удаленно или в офисе", expected: model.WorkFormatHybrid},
+		{name: "Только офис", text: "Работа в офисе на Таганке", expected: model.WorkFormatOffice},
+		{name: "MS Office не офис", text: "Знание MS Office", expected: ""},
+		{name: "Не указан", text: "Ищем Go разработчика", expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Location(tc.text).WorkFormat)
+		})
+	}
+}
+
+func TestLocationPlaces(t *testing.T) {
+	// GIVEN: Вакансия с ограничениями по стране, городом офиса и релокацией
+	text := "Удаленно, только РФ. Офис в Москве, возможна релокация на Кипр. Сайт example.ru"
+
+	// WHEN: Определяем местоположение
+	location := Location(text)
+
+	// THEN: Найдены страны, города и релокация
+	assert.Equal(t, []string{"RU", "CY"}, location.Countries)
+	assert.Equal(t, []string{"Москва"}, location.Cities)
+	assert.True(t, location.Relocation)
+}
+
+func TestLocationTimezone(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		min, max int
+		found    bool
+	}{
+		{name: "Пояс с допуском", text: "Часовой пояс UTC+3 ±2", min: 60, max: 300, found: true},
+		{name: "МСК с допуском", text: "Работа в пределах МСК +-3", min: 0, max: 360, found: true},
+		{name: "Диапазон", text: "Timezone: GMT+1 - GMT+4", min: 60, max: 240, found: true},
+		{name: "Один пояс с минутами", text: "Команда в UTC+05:30", min: 330, max: 330, found: true},
+		{name: "По московскому времени", text: "График 10-19 по МСК", min: 180, max: 180, found: true},
+		{name: "Не указан", text: "Гибкий график", found: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			location := Location(tc.text)
+			if !tc.found {
+				assert.Nil(t, location.TimezoneMin)
+				assert.Nil(t, location.TimezoneMax)
+				return
+			}
+
+			if assert.NotNil(t, location.TimezoneMin) && assert.NotNil(t, location.TimezoneMax) {
+				assert.Equal(t, tc.min, *location.TimezoneMin)
+				assert.Equal(t, tc.max, *location.TimezoneMax)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package extract
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+const (
+	salaryCurrencyPattern   = `₽|\$|€|£|₸|руб(?:лей|ля|\.)?|р\.|rub|rur|usdt|usd|долл(?:аров|ара|\.)?|eur|евро|kzt|тенге|byn|uah|грн\.?|gbp`
+	salaryNumberPattern     = `\d{1,3}(?:[ \x{00a0}\x{202f}.,]\d{3})+|\d+(?:[.,]\d{1,2})?`
+	salaryMultiplierPattern = `тыс(?:яч|\.)?|млн|kk|k|к`
+)
+
+// salaryAmountPattern - сумма с необязательными валютой (до или после числа) и множителем
+func salaryAmountPattern(n int) string {
+	return fmt.Sprintf(`(?:(?P<curPrefix%[1]d>%[2]s)\s?)?(?P<num%[1]d>%[3]s)(?:\s?(?P<mult%[1]d>%[4]s))?(?:\s?(?P<curSuffix%[1]d>%[2]s))?`,
+		n, salaryCurrencyPattern, salaryNumberPattern, salaryMultiplierPattern)
+}
+
+var (
+	// salaryRegexp находит одиночную сумму или вилку: "от 250 000 ₽", "$3-5k", "до 4000 USD", "от 200 до 300 тыс. руб"
+	salaryRegexp = regexp.MustCompile(`(?i)(?:(?P<from>от|from)\s*|(?P<upto>до|up to)\s*)?` +
+		salaryAmountPattern(1) +
+		`(?:\s*(?:-|–|—|до|to)\s*` + salaryAmountPattern(2) + `)?`)
+
+	// thousandsRegexp - число с разделителями разрядов: "250 000", "3.500"
+	thousandsRegexp = regexp.MustCompile(`^\d{1,3}(?:[ \x{00a0}\x{202f}.,]\d{3})+$`)
+
+	// salaryKeywordRegexp - слова, после которых число в строке считается зарплатой даже без валюты
+	salaryKeywordRegexp = regexp.MustCompile(`(?i)зп|з/п|зарплат|заработн|оклад|вилк|доход|компенсац|ставк|salary|compensation|\bpay\b|\brate\b`)
+
+	salaryNetRegexp   = wordPattern(`net|нетто|на руки|чистыми|после вычета`)
+	salaryGrossRegexp = wordPattern(`gross|гросс|брутто|до вычета`)
+
+	salaryHourRegexp = regexp.MustCompile(`(?i)/\s?(?:ч|час|h|hr|hour)(?:$|[^\p{L}])|` + `(?:в|за|per|an)\s(?:час|hour)|hourly`)
+	salaryDayRegexp  = regexp.MustCompile(`(?i)/\s?(?:день|day|d)(?:$|[^\p{L}])|` + `(?:в|за|per|a)\s(?:день|day)|daily`)
+	salaryYearRegexp = regexp.MustCompile(`(?i)/\s?(?:год|year|y|yr)(?:$|[^\p{L}])|` + `(?:в|за|per|a)\s(?:год|year)|annual|yearly|годовых`)
+)
+
+// salaryCurrencies сопоставляет префиксы записи валюты с кодами ISO 4217
+var salaryCurrencies = []struct {
+	prefix   string
+	currency string
+}{
+	{"₽", "RUB"}, {"руб", "RUB"}, {"р.", "RUB"}, {"rub", "RUB"}, {"rur", "RUB"},
+	{"$", "USD"}, {"usd", "USD"}, {"долл", "USD"},
+	{"€", "EUR"}, {"eur", "EUR"}, {"евро", "EUR"},
+	{"£", "GBP"}, {"gbp", "GBP"},
+	{"₸", "KZT"}, {"kzt", "KZT"}, {"тенге", "KZT"},
+	{"byn", "BYN"},
+	{"uah", "UAH"}, {"грн", "UAH"},
+}
+
+// minSalaryWithoutCurrency - минимальная сумма, которая считается зарплатой, если валюта не указана.
+// Отсекает "опыт 3-5 лет" и подобные числа рядом со словом "зарплата"
+const minSalaryWithoutCurrency = 1000
+
+// Salary ищет в тексте первую зарплатную вилку. Число считается зарплатой, если рядом указана
+// валюта или перед ним в той же строке есть слово вроде "зарплата", "оклад", "salary".
+// Валюта в результате может быть пустой; приведение к базовой валюте выполняет SalaryConfig.Normalize
+func Salary(text string) (model.Salary, bool) {
+	for pos := 0; pos < len(text); {
+		loc := salaryRegexp.FindStringSubmatchIndex(text[pos:])
+		if loc == nil {
+			break
+		}
+
+		for i := range loc {
+			if loc[i] >= 0 {
+				loc[i] += pos
+			}
+		}
+
+		if salary, ok := parseSalaryMatch(text, loc); ok {
+			return salary, true
+		}
+
+		// Кандидат не подошел: продолжаем поиск со следующего символа после его начала
+		_, size := utf8.DecodeRuneInString(text[loc[0]:])
+		pos = loc[0] + size
+	}
+
+	return model.Salary{}, false
+}
+
+// parseSalaryMatch проверяет совпадение salaryRegexp и разбирает его в Salary
+func parseSalaryMatch(text string, loc []int) (model.Salary, bool) {
+	group := func(name string) string {
+		i := salaryRegexp.SubexpIndex(name)
+		if loc[2*i] < 0 {
+			return ""
+		}
+		return text[loc[2*i]:loc[2*i+1]]
+	}
+
+	start, end := loc[0], loc[1]
+
+	// Совпадение должно начинаться и заканчиваться на границе слова: "h264", "5 kubernetes" не подходят
+	if isWordRune(prevRune(text, start)) || isWordRune(nextRune(text, end)) {
+		return model.Salary{}, false
+	}
+
+	first, ok := parseSalaryAmount(group("num1"), group("mult1"))
+	if !ok {
+		return model.Salary{}, false
+	}
+
+	var second float64
+	hasSecond := group("num2") != ""
+	if hasSecond {
+		if second, ok = parseSalaryAmount(group("num2"), group("mult2")); !ok {
+			return model.Salary{}, false
+		}
+
+		// "3-5k", "от 200 до 300 тыс.": множитель второй суммы относится и к первой
+		if group("mult1") == "" && group("mult2") != "" && first < minSalaryWithoutCurrency {
+			first *= salaryMultiplier(group("mult2"))
+		}
+	}
+
+	currency := ""
+	for _, name := range []string{"curPrefix1", "curSuffix1", "curPrefix2", "curSuffix2"} {
+		if c := group(name); c != "" {
+			currency = normalizeCurrency(c)
+			break
+		}
+	}
+
+	lineStart, lineEnd := lineBounds(text, start)
+	if currency == "" {
+		if !salaryKeywordRegexp.MatchString(text[lineStart:start]) {
+			return model.Salary{}, false
+		}
+		if math.Max(first, second) < minSalaryWithoutCurrency {
+			return model.Salary{}, false
+		}
+	}
+
+	salary := model.Salary{Currency: currency, Period: model.SalaryPeriodMonth}
+
+	switch {
+	case hasSecond:
+		salary.Min, salary.Max = round(first), round(second)
+	case group("from") != "":
+		salary.Min = round(first)
+	case group("upto") != "":
+		salary.Max = round(first)
+	default:
+		salary.Min, salary.Max = round(first), round(first)
+	}
+
+	if salary.Min > 0 && salary.Max > 0 && salary.Min > salary.Max {
+		salary.Min, salary.Max = salary.Max, salary.Min
+	}
+
+	line := text[lineStart:lineEnd]
+	switch {
+	case salaryNetRegexp.MatchString(line):
+		salary.Tax = model.SalaryTaxNet
+	case salaryGrossRegexp.MatchString(line):
+		salary.Tax = model.SalaryTaxGross
+	}
+
+	// Период обычно указывают сразу после суммы: "$50/hour", "300к в год"
+	after := text[end:lineEnd]
+	switch {
+	case salaryHourRegexp.MatchString(after):
+		salary.Period = model.SalaryPeriodHour
+	case salaryDayRegexp.MatchString(after):
+		salary.Period = model.SalaryPeriodDay
+	case salaryYearRegexp.MatchString(after):
+		salary.Period = model.SalaryPeriodYear
+	}
+
+	return salary, true
+}
+
+// parseSalaryAmount разбирает число с учетом разделителей разрядов и множителя ("250 000", "3.5k")
+func parseSalaryAmount(number, multiplier string) (float64, bool) {
+	var value float64
+
+	if thousandsRegexp.MatchString(number) {
+		digits := strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, number)
+
+		v, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		value = float64(v)
+	} else {
+		v, err := strconv.ParseFloat(strings.ReplaceAll(number, ",", "."), 64)
+		if err != nil {
+			return 0, false
+		}
+		value = v
+	}
+
+	value *= salaryMultiplier(multiplier)
+	if value <= 0 {
+		return 0, false
+	}
+
+	return value, true
+}
+
+// salaryMultiplier возвращает множитель для сокращений "k", "тыс.", "млн"
+func salaryMultiplier(multiplier string) float64 {
+	switch strings.ToLower(multiplier) {
+	case "":
+		return 1
+	case "млн", "kk":
+		return 1_000_000
+	default:
+		return 1000
+	}
+}
+
+// normalizeCurrency приводит запись валюты к коду ISO 4217
+func normalizeCurrency(currency string) string {
+	currency = strings.ToLower(currency)
+	for _, c := range salaryCurrencies {
+		if strings.HasPrefix(currency, c.prefix) {
+			return c.currency
+		}
+	}
+	return ""
+}
+
+// round округляет сумму до целого
+func round(value float64) int64 {
+	return int64(math.Round(value))
+}
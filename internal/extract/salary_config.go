@@ -0,0 +1,80 @@
+package extract
+
+import (
+	"math"
+	"strings"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// Сколько периодов каждого вида приходится на месяц при приведении зарплаты к месячной
+var salaryPeriodsPerMonth = map[string]float64{
+	model.SalaryPeriodHour:  160,
+	model.SalaryPeriodDay:   21,
+	model.SalaryPeriodMonth: 1,
+	model.SalaryPeriodYear:  1.0 / 12,
+}
+
+// SalaryConfig задает приведение зарплат к базовой валюте
+type SalaryConfig struct {
+	// BaseCurrency - валюта, в которой хранятся приведенные суммы
+	BaseCurrency string `yaml:"base_currency"`
+	// DefaultCurrency подставляется, если в вакансии валюта не указана (пусто - не подставлять)
+	DefaultCurrency string `yaml:"default_currency"`
+	// Rates - стоимость единицы валюты в BaseCurrency, например USD: 90
+	Rates map[string]float64 `yaml:"rates"`
+}
+
+// DefaultSalaryConfig возвращает конфигурацию с рублем в качестве базовой валюты
+func DefaultSalaryConfig() SalaryConfig {
+	return SalaryConfig{
+		BaseCurrency:    "RUB",
+		DefaultCurrency: "RUB",
+		Rates: map[string]float64{
+			"USD": 90,
+			"EUR": 100,
+			"GBP": 115,
+			"KZT": 0.18,
+			"BYN": 28,
+			"UAH": 2.2,
+		},
+	}
+}
+
+// rate возвращает курс валюты к базовой; ok = false, если курс неизвестен
+func (c SalaryConfig) rate(currency string) (float64, bool) {
+	if strings.EqualFold(currency, c.BaseCurrency) {
+		return 1, true
+	}
+
+	for code, rate := range c.Rates {
+		if strings.EqualFold(code, currency) {
+			return rate, true
+		}
+	}
+
+	return 0, false
+}
+
+// Normalize подставляет валюту по умолчанию и заполняет MinBase/MaxBase - вилку в базовой валюте за месяц.
+// Если курс валюты неизвестен, приведенные суммы остаются нулевыми
+func (c SalaryConfig) Normalize(salary *model.Salary) {
+	if salary.Currency == "" {
+		salary.Currency = strings.ToUpper(c.DefaultCurrency)
+	}
+
+	salary.MinBase, salary.MaxBase = 0, 0
+
+	rate, ok := c.rate(salary.Currency)
+	if !ok {
+		return
+	}
+
+	perMonth, ok := salaryPeriodsPerMonth[salary.Period]
+	if !ok {
+		perMonth = 1
+	}
+
+	salary.MinBase = int64(math.Round(float64(salary.Min) * rate * perMonth))
+	salary.MaxBase = int64(math.Round(float64(salary.Max) * rate * perMonth))
+}
@@ -0,0 +1,129 @@
+package extract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+func TestSalary(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected model.Salary
+		found    bool
+	}{
+		{
+			name:     "Нижняя граница в рублях",
+			text:     "Go разработчик\nЗП: от 250 000 ₽",
+			expected: model.Salary{Min: 250000, Currency: "RUB", Period: model.SalaryPeriodMonth},
+			found:    true,
+		},
+		{
+			name:     "Вилка в тысячах долларов",
+			text:     "Senior Python, $3-5k",
+			expected: model.Salary{Min: 3000, Max: 5000, Currency: "USD", Period: model.SalaryPeriodMonth},
+			found:    true,
+		},
+		{
+			name:     "Верхняя граница net",
+			text:     "Оплата до 4000 USD net",
+			expected: model.Salary{Max: 4000, Currency: "USD", Period: model.SalaryPeriodMonth, Tax: model.SalaryTaxNet},
+			found:    true,
+		},
+		{
+			name:     "От и до с множителем во второй сумме",
+			text:     "Вилка от 200 до 300 тыс. руб. gross",
+			expected: model.Salary{Min: 200000, Max: 300000, Currency: "RUB", Period: model.SalaryPeriodMonth, Tax: model.SalaryTaxGross},
+			found:    true,
+		},
+		{
+			name:     "Евро в год",
+			text:     "Compensation: 60 000 - 80 000 EUR per year",
+			expected: model.Salary{Min: 60000, Max: 80000, Currency: "EUR", Period: model.SalaryPeriodYear},
+			found:    true,
+		},
+		{
+			name:     "Почасовая ставка",
+			text:     "Rate: $40/hour",
+			expected: model.Salary{Min: 40, Max: 40, Currency: "USD", Period: model.SalaryPeriodHour},
+			found:    true,
+		},
+		{
+			name:     "Без валюты после слова зарплата",
+			text:     "Зарплата 150к-200к на руки",
+			expected: model.Salary{Min: 150000, Max: 200000, Period: model.SalaryPeriodMonth, Tax: model.SalaryTaxNet},
+			found:    true,
+		},
+		{
+			name:  "Опыт работы не считается зарплатой",
+			text:  "Зарплата обсуждается, опыт 3-5 лет",
+			found: false,
+		},
+		{
+			name:  "Число без валюты и ключевого слова",
+			text:  "Команда из 15 000 сотрудников",
+			found: false,
+		},
+		{
+			name:     ".NET не считается признаком net",
+			text:     "Senior .NET developer, 300 000 руб",
+			expected: model.Salary{Min: 300000, Max: 300000, Currency: "RUB", Period: model.SalaryPeriodMonth},
+			found:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			salary, found := Salary(tc.text)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expected, salary)
+		})
+	}
+}
+
+func TestSalaryConfigNormalize(t *testing.T) {
+	config := SalaryConfig{
+		BaseCurrency:    "RUB",
+		DefaultCurrency: "RUB",
+		Rates:           map[string]float64{"usd": 90},
+	}
+
+	t.Run("перевод в базовую валюту", func(t *testing.T) {
+		// GIVEN: Вилка в долларах за месяц
+		salary := model.Salary{Min: 3000, Max: 5000, Currency: "USD", Period: model.SalaryPeriodMonth}
+
+		// WHEN: Приводим к базовой валюте
+		config.Normalize(&salary)
+
+		// THEN: Суммы пересчитаны по курсу
+		assert.Equal(t, int64(270000), salary.MinBase)
+		assert.Equal(t, int64(450000), salary.MaxBase)
+	})
+
+	t.Run("годовая зарплата приводится к месячной", func(t *testing.T) {
+		// GIVEN: Годовая зарплата без валюты
+		salary := model.Salary{Min: 1200000, Period: model.SalaryPeriodYear}
+
+		// WHEN: Приводим к базовой валюте
+		config.Normalize(&salary)
+
+		// THEN: Подставлена валюта по умолчанию, сумма пересчитана в месячную
+		assert.Equal(t, "RUB", salary.Currency)
+		assert.Equal(t, int64(100000), salary.MinBase)
+		assert.Equal(t, int64(0), salary.MaxBase)
+	})
+
+	t.Run("неизвестная валюта", func(t *testing.T) {
+		// GIVEN: Вилка в валюте без курса
+		salary := model.Salary{Min: 1000, Max: 2000, Currency: "GBP", Period: model.SalaryPeriodMonth}
+
+		// WHEN: Приводим к базовой валюте
+		config.Normalize(&salary)
+
+		// THEN: Приведенные суммы не заполнены
+		assert.Zero(t, salary.MinBase)
+		assert.Zero(t, salary.MaxBase)
+	})
+}
@@ -0,0 +1,69 @@
+package extract
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+)
+
+// shingleSize - количество слов в шингле, по которым считается SimHash
+const shingleSize = 3
+
+// minSimHashWords - минимальное количество слов в тексте, при котором SimHash надежно отличает
+// вакансии друг от друга; у более коротких текстов совпадение отпечатков ничего не значит
+const minSimHashWords = 10
+
+// SimHash возвращает 64-битный отпечаток текста для поиска почти одинаковых вакансий.
+// Текст приводится к нижнему регистру и разбивается на слова, отпечаток строится по шинглам
+// из shingleSize слов, поэтому пунктуация и небольшие правки (подпись канала) меняют лишь несколько бит. Для текстов короче minSimHashWords слов возвращает false
+func SimHash(text string) (uint64, bool) {
+	words := simHashWords(text)
+	if len(words) < minSimHashWords {
+		return 0, false
+	}
+
+	var weights [64]int
+	for i := 0; i+shingleSize <= len(words); i++ {
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[i:i+shingleSize], " ")))
+		sum := h.Sum64()
+
+		for bit := range weights {
+			if sum&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var hash uint64
+	for bit, weight := range weights {
+		if weight > 0 {
+			hash |= 1 << bit
+		}
+	}
+
+	return hash, true
+}
+
+// simHashWords разбивает текст на слова в нижнем регистре. Хэштеги, упоминания и ссылки пропускаются:
+// каналы добавляют их к репостам, и они не относятся к самой вакансии
+func simHashWords(text string) []string {
+	var words []string
+	for _, field := range strings.Fields(strings.ToLower(text)) {
+		if strings.HasPrefix(field, "#") || strings.HasPrefix(field, "@") || strings.Contains(field, "://") {
+			continue
+		}
+
+		words = append(words, strings.FieldsFunc(field, func(r rune) bool {
+			return !isWordRune(r)
+		})...)
+	}
+	return words
+}
+
+// HammingDistance возвращает количество различающихся бит двух отпечатков SimHash
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
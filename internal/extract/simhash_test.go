@@ -0,0 +1,54 @@
+package extract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimHash(t *testing.T) {
+	original := `Golang разработчик в финтех. Удаленно, полная занятость. Зарплата от 300 000 ₽.
+Требования: опыт коммерческой разработки на Go от 3 лет, PostgreSQL, Kafka, Kubernetes.
+Задачи: разработка платежных микросервисов, оптимизация производительности, код-ревью.
+Контакты: @hr_fintech`
+
+	t.Run("Репост с подписью канала и хэштегами почти не меняет отпечаток", func(t *testing.T) {
+		// GIVEN: Тот же текст в другом канале с другой разметкой и подписью
+		repost := "#golang #remote\n" + original + "\n\nПодписывайтесь на @rabota_razrabotchikq!"
+
+		// WHEN: Считаем отпечатки
+		a, okA := SimHash(original)
+		b, okB := SimHash(repost)
+
+		// THEN: Отпечатки отличаются не больше чем на несколько бит
+		require.True(t, okA)
+		require.True(t, okB)
+		assert.LessOrEqual(t, HammingDistance(a, b), 3)
+	})
+
+	t.Run("Регистр и пунктуация не влияют на отпечаток", func(t *testing.T) {
+		a, _ := SimHash(original)
+		b, _ := SimHash("  " + original + "!!!")
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("Разные вакансии сильно различаются", func(t *testing.T) {
+		// GIVEN: Другая вакансия
+		other := `Senior Python разработчик в e-commerce. Офис в Москве, гибридный график.
+Требования: Django, FastAPI, Redis, опыт проектирования высоконагруженных систем от 5 лет.
+Задачи: развитие каталога товаров, интеграции с маркетплейсами. Резюме на jobs@shop.ru`
+
+		// WHEN: Считаем отпечатки
+		a, _ := SimHash(original)
+		b, _ := SimHash(other)
+
+		// THEN: Расстояние значительно больше порога дубликатов
+		assert.Greater(t, HammingDistance(a, b), 10)
+	})
+
+	t.Run("Короткий текст", func(t *testing.T) {
+		_, ok := SimHash("Ищем Go разработчика")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,88 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	XMLNS    string      `xml:"xmlns,attr"`
+	Lang     string      `xml:"xml:lang,attr"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Author   atomAuthor  `xml:"author"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Summary    string         `xml:"summary,omitempty"`
+	Content    atomContent    `xml:"content"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+func renderAtom(w io.Writer, feed Feed) error {
+	document := atomFeed{
+		XMLNS:    atomNamespace,
+		Lang:     "ru",
+		ID:       feed.ID,
+		Title:    feed.Title,
+		Subtitle: feed.Description,
+		Updated:  feed.Updated.UTC().Format(time.RFC3339),
+		Author:   atomAuthor{Name: feed.Title},
+	}
+	if feed.Link != "" {
+		document.Links = append(document.Links, atomLink{Href: feed.Link, Rel: "self", Type: "application/atom+xml"})
+	}
+	if feed.SiteURL != "" {
+		document.Links = append(document.Links, atomLink{Href: feed.SiteURL, Rel: "alternate", Type: "text/html"})
+	}
+
+	for _, job := range feed.Jobs {
+		entry := atomEntry{
+			ID:        jobID(job),
+			Title:     job.DisplayTitle(),
+			Link:      atomLink{Href: job.SourceLink, Rel: "alternate"},
+			Published: job.DatePosted.UTC().Format(time.RFC3339),
+			Updated:   job.DateParsed.UTC().Format(time.RFC3339),
+			Summary:   jobSummary(job),
+			Content:   atomContent{Type: "html", Value: job.Content},
+		}
+		for _, category := range jobCategories(job) {
+			entry.Categories = append(entry.Categories, atomCategory{Term: category})
+		}
+		document.Entries = append(document.Entries, entry)
+	}
+
+	return writeXML(w, document)
+}
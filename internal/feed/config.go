@@ -0,0 +1,31 @@
+package feed
+
+// Config задает параметры лент вакансий
+type Config struct {
+	// Title и Description - заголовок и описание ленты; к заголовку добавляется технология, хэштег или канал
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+	// BaseURL - публичный адрес каталога лент (HTTP API /feeds или статические файлы), например
+	// https://example.com/feeds. Из него строятся ссылки лент на самих себя; пустой - ссылок нет
+	BaseURL string `yaml:"base_url"`
+	// SiteURL - адрес сайта с вакансиями для ссылки ленты на сайт (link в RSS, alternate в Atom,
+	// home_page_url в JSON Feed). По умолчанию - site.base_url; пустой - ссылки нет
+	SiteURL string `yaml:"site_url"`
+	// Limit - сколько последних вакансий попадает в ленту
+	Limit int `yaml:"limit"`
+	// Tags - для скольких самых популярных хэштегов записываются статические ленты; 0 - для всех
+	Tags int `yaml:"tags"`
+	// Dir - каталог для статических лент
+	Dir string `yaml:"dir"`
+}
+
+// DefaultConfig возвращает конфигурацию лент по умолчанию
+func DefaultConfig() Config {
+	return Config{
+		Title:       "Удаленные вакансии",
+		Description: "Свежие вакансии из Telegram-каналов",
+		Limit:       50,
+		Tags:        100,
+		Dir:         "feeds",
+	}
+}
@@ -0,0 +1,233 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/utils"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// idPrefix - префикс постоянных идентификаторов лент и вакансий в Atom; идентификатор вакансии
+// строится из slug и не зависит от адреса, по которому опубликована лента
+const idPrefix = "urn:remotejobs:"
+
+// Format - формат ленты
+type Format string
+
+// Поддерживаемые форматы лент
+const (
+	FormatRSS  Format = "rss"
+	FormatAtom Format = "atom"
+	FormatJSON Format = "json"
+)
+
+// Formats - все форматы лент
+var Formats = []Format{FormatRSS, FormatAtom, FormatJSON}
+
+// FileName возвращает имя файла ленты в формате f
+func (f Format) FileName() string {
+	switch f {
+	case FormatRSS:
+		return "rss.xml"
+	case FormatAtom:
+		return "atom.xml"
+	default:
+		return "feed.json"
+	}
+}
+
+// ContentType возвращает MIME-тип ленты в формате f
+func (f Format) ContentType() string {
+	switch f {
+	case FormatRSS:
+		return "application/rss+xml; charset=utf-8"
+	case FormatAtom:
+		return "application/atom+xml; charset=utf-8"
+	default:
+		return "application/feed+json; charset=utf-8"
+	}
+}
+
+// FormatByFileName возвращает формат по имени файла ленты (rss.xml, atom.xml, feed.json)
+func FormatByFileName(name string) (Format, bool) {
+	for _, format := range Formats {
+		if format.FileName() == name {
+			return format, true
+		}
+	}
+	return "", false
+}
+
+// Каталоги лент технологий, хэштегов и каналов относительно корня лент
+const (
+	technologiesDir = "technologies"
+	tagsDir         = "tags"
+	channelsDir     = "channels"
+)
+
+// Filter ограничивает ленту одной технологией, хэштегом или каналом; пустой фильтр - все вакансии
+type Filter struct {
+	Technology string
+	Tag        string
+	Channel    string
+}
+
+// Dir возвращает каталог ленты относительно корня лент: "", "technologies/<slug>",
+// "tags/<tag>" или "channels/<tag>"
+func (f Filter) Dir() string {
+	switch {
+	case f.Technology != "":
+		return technologiesDir + "/" + utils.TechnologySlug(f.Technology)
+	case f.Tag != "":
+		return tagsDir + "/" + strings.ToLower(strings.TrimPrefix(f.Tag, "#"))
+	case f.Channel != "":
+		return channelsDir + "/" + strings.TrimPrefix(f.Channel, "@")
+	default:
+		return ""
+	}
+}
+
+// Feed - лента из последних вакансий
+type Feed struct {
+	// ID - постоянный идентификатор ленты
+	ID          string
+	Title       string
+	Description string
+	// Link - адрес ленты, пустой, если не задан BaseURL
+	Link string
+	// SiteURL - адрес сайта с вакансиями, пустой, если не задан
+	SiteURL string
+	// Updated - время сбора самой свежей вакансии ленты
+	Updated time.Time
+	Jobs    []model.JobRaw
+}
+
+// Generator собирает ленты из активных вакансий
+type Generator struct {
+	repository repository.JobsRepository
+	config     Config
+}
+
+func NewGenerator(repository repository.JobsRepository, config Config) *Generator {
+	return &Generator{
+		repository: repository,
+		config:     config,
+	}
+}
+
+// Build собирает ленту последних активных вакансий по фильтру для формата format
+func (g *Generator) Build(ctx context.Context, filter Filter, format Format) (Feed, error) {
+	op := "feed.Build"
+
+	page, err := g.repository.ListJobs(ctx, model.JobFilter{
+		Technology: filter.Technology,
+		Tag:        filter.Tag,
+		Channel:    filter.Channel,
+		Limit:      g.config.Limit,
+	})
+	if err != nil {
+		return Feed{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	feed := Feed{
+		ID:          idPrefix + "feed",
+		Title:       g.config.Title,
+		Description: g.config.Description,
+		SiteURL:     g.config.SiteURL,
+		Jobs:        page.Jobs,
+	}
+
+	path := format.FileName()
+	if dir := filter.Dir(); dir != "" {
+		feed.ID += ":" + dir
+		section, name, _ := strings.Cut(dir, "/")
+		path = section + "/" + url.PathEscape(name) + "/" + path
+	}
+
+	switch {
+	case filter.Technology != "":
+		feed.Title += " — " + filter.Technology
+	case filter.Tag != "":
+		feed.Title += " — #" + strings.TrimPrefix(filter.Tag, "#")
+	case filter.Channel != "":
+		feed.Title += " — @" + strings.TrimPrefix(filter.Channel, "@")
+	}
+
+	if g.config.BaseURL != "" {
+		feed.Link = strings.TrimSuffix(g.config.BaseURL, "/") + "/" + path
+	}
+
+	for _, job := range page.Jobs {
+		if job.DateParsed.After(feed.Updated) {
+			feed.Updated = job.DateParsed
+		}
+	}
+	if feed.Updated.IsZero() {
+		feed.Updated = time.Now()
+	}
+
+	return feed, nil
+}
+
+// Render записывает ленту в w в формате format
+func Render(w io.Writer, feed Feed, format Format) error {
+	switch format {
+	case FormatRSS:
+		return renderRSS(w, feed)
+	case FormatAtom:
+		return renderAtom(w, feed)
+	case FormatJSON:
+		return renderJSON(w, feed)
+	default:
+		return fmt.Errorf("неизвестный формат ленты %q", format)
+	}
+}
+
+// jobID возвращает постоянный идентификатор вакансии в ленте
+func jobID(job model.JobRaw) string {
+	return idPrefix + "job:" + job.Slug
+}
+
+// jobCategories возвращает технологии и хэштеги вакансии без повторов
+func jobCategories(job model.JobRaw) []string {
+	seen := make(map[string]bool)
+	var categories []string
+
+	add := func(category string) {
+		if category != "" && !seen[category] {
+			seen[category] = true
+			categories = append(categories, category)
+		}
+	}
+
+	add(job.MainTechnology)
+	for _, tech := range job.Technologies {
+		add(tech.Technology)
+	}
+	for _, tag := range job.Tags {
+		add(tag)
+	}
+
+	return categories
+}
+
+// summaryLength - максимальная длина краткого описания вакансии в символах
+const summaryLength = 300
+
+// jobSummary возвращает начало текста вакансии без разметки
+func jobSummary(job model.JobRaw) string {
+	text := strings.Join(strings.Fields(job.ContentPure), " ")
+
+	runes := []rune(text)
+	if len(runes) <= summaryLength {
+		return text
+	}
+
+	return strings.TrimSpace(string(runes[:summaryLength])) + "…"
+}
@@ -0,0 +1,328 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+// newTestGenerator создает генератор лент поверх мок-репозитория с вакансиями golang и C++
+func newTestGenerator(t *testing.T) (*Generator, *test.MockRepository) {
+	repo := test.NewMockRepository(zaptest.NewLogger(t))
+
+	posted := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i, technology := range []string{"golang", "C++"} {
+		job := test.CreateMockJob(int64(i+1), technology)
+		job.DatePosted = posted.AddDate(0, 0, i)
+		job.DateParsed = job.DatePosted.Add(time.Hour)
+		job.Technologies = []model.JobTechnology{{TechnologyID: int64(i + 1), Technology: technology, Score: 3}}
+		job.Tags = []string{"remote"}
+		repo.Jobs = append(repo.Jobs, job)
+	}
+	repo.Technologies = []model.Technology{
+		test.CreateMockTechnology(1, "golang", 0, "go"),
+		test.CreateMockTechnology(2, "C++", 1, "c++"),
+	}
+	repo.TelegramChannels = []model.TelegramChannel{{ID: 1, Tag: "test_channel"}}
+
+	config := DefaultConfig()
+	config.BaseURL = "https://example.com/feeds/"
+	config.SiteURL = "https://example.com/"
+
+	return NewGenerator(repo, config), repo
+}
+
+// TestBuild проверяет сборку ленты по фильтрам согласно шаблону GIVEN-WHEN-THEN
+func TestBuild(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("общая лента", func(t *testing.T) {
+		// GIVEN: Генератор с двумя вакансиями
+		generator, _ := newTestGenerator(t)
+
+		// WHEN: Собираем общую ленту
+		feed, err := generator.Build(ctx, Filter{}, FormatRSS)
+
+		// THEN: В ленте обе вакансии от новых к старым, время обновления - сбор самой свежей
+		require.NoError(t, err)
+		assert.Equal(t, "urn:remotejobs:feed", feed.ID)
+		assert.Equal(t, "https://example.com/feeds/rss.xml", feed.Link)
+		assert.Equal(t, DefaultConfig().Title, feed.Title)
+		require.Len(t, feed.Jobs, 2)
+		assert.Equal(t, int64(2), feed.Jobs[0].ID)
+		assert.Equal(t, feed.Jobs[0].DateParsed, feed.Updated)
+	})
+
+	t.Run("лента технологии", func(t *testing.T) {
+		// GIVEN: Генератор с двумя вакансиями
+		generator, _ := newTestGenerator(t)
+
+		// WHEN: Собираем ленту C++
+		feed, err := generator.Build(ctx, Filter{Technology: "C++"}, FormatAtom)
+
+		// THEN: Ссылка и идентификатор строятся из slug технологии
+		require.NoError(t, err)
+		assert.Equal(t, "urn:remotejobs:feed:technologies/c-plus-plus", feed.ID)
+		assert.Equal(t, "https://example.com/feeds/technologies/c-plus-plus/atom.xml", feed.Link)
+		assert.Equal(t, DefaultConfig().Title+" — C++", feed.Title)
+		require.Len(t, feed.Jobs, 1)
+		assert.Equal(t, "C++", feed.Jobs[0].MainTechnology)
+	})
+
+	t.Run("ленты хэштега и канала", func(t *testing.T) {
+		// GIVEN: Генератор с двумя вакансиями
+		generator, _ := newTestGenerator(t)
+
+		// WHEN: Собираем ленты хэштега и канала
+		byTag, err := generator.Build(ctx, Filter{Tag: "#remote"}, FormatJSON)
+		require.NoError(t, err)
+		byChannel, err := generator.Build(ctx, Filter{Channel: "@test_channel"}, FormatJSON)
+		require.NoError(t, err)
+
+		// THEN: Префиксы # и @ отбрасываются в путях
+		assert.Equal(t, "https://example.com/feeds/tags/remote/feed.json", byTag.Link)
+		assert.Len(t, byTag.Jobs, 2)
+		assert.Equal(t, "https://example.com/feeds/channels/test_channel/feed.json", byChannel.Link)
+		assert.Len(t, byChannel.Jobs, 2)
+	})
+
+	t.Run("без base_url ссылки нет", func(t *testing.T) {
+		// GIVEN: Генератор без BaseURL
+		repo := test.NewMockRepository(zaptest.NewLogger(t))
+		generator := NewGenerator(repo, DefaultConfig())
+
+		// WHEN: Собираем пустую ленту
+		feed, err := generator.Build(ctx, Filter{}, FormatRSS)
+
+		// THEN: Ссылки нет, время обновления задано
+		require.NoError(t, err)
+		assert.Empty(t, feed.Link)
+		assert.Empty(t, feed.Jobs)
+		assert.False(t, feed.Updated.IsZero())
+	})
+}
+
+// TestRender проверяет форматы лент согласно шаблону GIVEN-WHEN-THEN
+func TestRender(t *testing.T) {
+	ctx := context.Background()
+	generator, _ := newTestGenerator(t)
+
+	t.Run("RSS 2.0 использует slug как guid", func(t *testing.T) {
+		// GIVEN: Собранная лента
+		feed, err := generator.Build(ctx, Filter{}, FormatRSS)
+		require.NoError(t, err)
+
+		// WHEN: Выводим ленту в RSS
+		var buf bytes.Buffer
+		require.NoError(t, Render(&buf, feed, FormatRSS))
+
+		// THEN: Документ разбирается, guid - slug, HTML экранирован
+		var document struct {
+			Version string `xml:"version,attr"`
+			Channel struct {
+				Title string `xml:"title"`
+				Items []struct {
+					Title string `xml:"title"`
+					Link  string `xml:"link"`
+					GUID  struct {
+						IsPermaLink string `xml:"isPermaLink,attr"`
+						Value       string `xml:",chardata"`
+					} `xml:"guid"`
+					PubDate     string   `xml:"pubDate"`
+					Description string   `xml:"description"`
+					Categories  []string `xml:"category"`
+				} `xml:"item"`
+			} `xml:"channel"`
+		}
+		require.NoError(t, xml.Unmarshal(buf.Bytes(), &document))
+		assert.Equal(t, "2.0", document.Version)
+		require.Len(t, document.Channel.Items, 2)
+
+		item := document.Channel.Items[0]
+		assert.Equal(t, "testovaya-vakansiya-2", item.GUID.Value)
+		assert.Equal(t, "false", item.GUID.IsPermaLink)
+		assert.Equal(t, "https://t.me/test_channel/2", item.Link)
+		assert.Equal(t, "Fri, 02 Oct 2026 12:00:00 +0000", item.PubDate)
+		assert.Equal(t, feed.Jobs[0].Content, item.Description)
+		assert.Equal(t, []string{"C++", "remote"}, item.Categories)
+		assert.Contains(t, buf.String(), `<link>https://example.com/</link>`)
+		assert.Contains(t, buf.String(), `<atom:link href="https://example.com/feeds/rss.xml" rel="self"`)
+		assert.Contains(t, buf.String(), "&lt;p&gt;")
+	})
+
+	t.Run("Atom использует постоянный id из slug", func(t *testing.T) {
+		// GIVEN: Собранная лента
+		feed, err := generator.Build(ctx, Filter{}, FormatAtom)
+		require.NoError(t, err)
+
+		// WHEN: Выводим ленту в Atom
+		var buf bytes.Buffer
+		require.NoError(t, Render(&buf, feed, FormatAtom))
+
+		// THEN: Документ разбирается, id записи строится из slug
+		var document struct {
+			ID      string `xml:"id"`
+			Updated string `xml:"updated"`
+			Entries []struct {
+				ID      string `xml:"id"`
+				Updated string `xml:"updated"`
+				Content struct {
+					Type  string `xml:"type,attr"`
+					Value string `xml:",chardata"`
+				} `xml:"content"`
+			} `xml:"entry"`
+		}
+		require.NoError(t, xml.Unmarshal(buf.Bytes(), &document))
+		assert.Equal(t, "urn:remotejobs:feed", document.ID)
+		assert.Equal(t, "2026-10-02T13:00:00Z", document.Updated)
+		require.Len(t, document.Entries, 2)
+		assert.Equal(t, "urn:remotejobs:job:testovaya-vakansiya-2", document.Entries[0].ID)
+		assert.Equal(t, "html", document.Entries[0].Content.Type)
+		assert.Contains(t, buf.String(), `<link href="https://example.com/" rel="alternate" type="text/html">`)
+		assert.Equal(t, feed.Jobs[0].Content, document.Entries[0].Content.Value)
+	})
+
+	t.Run("JSON Feed 1.1", func(t *testing.T) {
+		// GIVEN: Собранная лента
+		feed, err := generator.Build(ctx, Filter{}, FormatJSON)
+		require.NoError(t, err)
+
+		// WHEN: Выводим ленту в JSON Feed
+		var buf bytes.Buffer
+		require.NoError(t, Render(&buf, feed, FormatJSON))
+
+		// THEN: id записи - slug, ссылка - пост в Telegram
+		var document struct {
+			Version     string `json:"version"`
+			HomePageURL string `json:"home_page_url"`
+			FeedURL     string `json:"feed_url"`
+			Items       []struct {
+				ID            string   `json:"id"`
+				URL           string   `json:"url"`
+				DatePublished string   `json:"date_published"`
+				Tags          []string `json:"tags"`
+			} `json:"items"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &document))
+		assert.Equal(t, "https://jsonfeed.org/version/1.1", document.Version)
+		assert.Equal(t, "https://example.com/", document.HomePageURL)
+		assert.Equal(t, "https://example.com/feeds/feed.json", document.FeedURL)
+		require.Len(t, document.Items, 2)
+		assert.Equal(t, "testovaya-vakansiya-2", document.Items[0].ID)
+		assert.Equal(t, "https://t.me/test_channel/2", document.Items[0].URL)
+		assert.Equal(t, "2026-10-02T12:00:00Z", document.Items[0].DatePublished)
+	})
+
+	t.Run("пустая JSON-лента содержит пустой массив", func(t *testing.T) {
+		// GIVEN: Лента без вакансий
+		var buf bytes.Buffer
+
+		// WHEN: Выводим ее в JSON Feed
+		require.NoError(t, Render(&buf, Feed{Title: "пусто", Updated: time.Now()}, FormatJSON))
+
+		// THEN: items - пустой массив, а не null
+		assert.Contains(t, buf.String(), `"items": []`)
+	})
+}
+
+// TestFormatByFileName проверяет определение формата по имени файла согласно шаблону GIVEN-WHEN-THEN
+func TestFormatByFileName(t *testing.T) {
+	// GIVEN: Имена файлов всех форматов
+	for _, format := range Formats {
+		// WHEN: Определяем формат по имени файла
+		found, ok := FormatByFileName(format.FileName())
+
+		// THEN: Формат совпадает
+		assert.True(t, ok)
+		assert.Equal(t, format, found)
+	}
+
+	// THEN: Неизвестное имя не распознается
+	_, ok := FormatByFileName("index.html")
+	assert.False(t, ok)
+}
+
+// TestWriteFiles проверяет запись статических лент согласно шаблону GIVEN-WHEN-THEN
+func TestWriteFiles(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ленты общие, технологий, хэштегов и каналов", func(t *testing.T) {
+		// GIVEN: Генератор с двумя технологиями, одним хэштегом и одним каналом
+		generator, _ := newTestGenerator(t)
+		dir := t.TempDir()
+
+		// WHEN: Записываем статические ленты
+		written, err := generator.WriteFiles(ctx, dir)
+
+		// THEN: По три формата на общую ленту, две технологии, хэштег и канал
+		require.NoError(t, err)
+		assert.Equal(t, 15, written)
+		for _, path := range []string{
+			"rss.xml",
+			"atom.xml",
+			"feed.json",
+			"technologies/golang/rss.xml",
+			"technologies/c-plus-plus/feed.json",
+			"tags/remote/rss.xml",
+			"channels/test_channel/atom.xml",
+		} {
+			assert.FileExists(t, filepath.Join(dir, path))
+		}
+
+		// THEN: Временных файлов не осталось
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		for _, entry := range entries {
+			assert.NotEqual(t, '.', rune(entry.Name()[0]), entry.Name())
+		}
+	})
+
+	t.Run("ленты удаленных технологий, хэштегов и каналов удаляются", func(t *testing.T) {
+		// GIVEN: Записанные ленты, после чего технология, канал и вакансии с хэштегом удалены
+		generator, repo := newTestGenerator(t)
+		dir := t.TempDir()
+		_, err := generator.WriteFiles(ctx, dir)
+		require.NoError(t, err)
+
+		repo.Technologies = repo.Technologies[:1]
+		repo.TelegramChannels = nil
+		for i := range repo.Jobs {
+			repo.Jobs[i].Tags = nil
+		}
+
+		// WHEN: Записываем статические ленты повторно
+		written, err := generator.WriteFiles(ctx, dir)
+
+		// THEN: Остались общие ленты и лента оставшейся технологии, устаревшие каталоги удалены
+		require.NoError(t, err)
+		assert.Equal(t, 6, written)
+		assert.FileExists(t, filepath.Join(dir, "technologies", "golang", "rss.xml"))
+		assert.NoDirExists(t, filepath.Join(dir, "technologies", "c-plus-plus"))
+		assert.NoDirExists(t, filepath.Join(dir, "tags", "remote"))
+		assert.NoDirExists(t, filepath.Join(dir, "channels", "test_channel"))
+	})
+
+	t.Run("ошибка репозитория", func(t *testing.T) {
+		// GIVEN: Репозиторий возвращает ошибки
+		generator, repo := newTestGenerator(t)
+		repo.ShouldError = true
+
+		// WHEN: Записываем статические ленты
+		written, err := generator.WriteFiles(ctx, t.TempDir())
+
+		// THEN: Ошибка возвращается, файлы не записаны
+		assert.Error(t, err)
+		assert.Equal(t, 0, written)
+	})
+}
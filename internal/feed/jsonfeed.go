@@ -0,0 +1,59 @@
+package feed
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	HomePageURL string         `json:"home_page_url,omitempty"`
+	FeedURL     string         `json:"feed_url,omitempty"`
+	Language    string         `json:"language"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url"`
+	Title         string   `json:"title"`
+	ContentHTML   string   `json:"content_html"`
+	Summary       string   `json:"summary,omitempty"`
+	DatePublished string   `json:"date_published"`
+	DateModified  string   `json:"date_modified"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+func renderJSON(w io.Writer, feed Feed) error {
+	document := jsonFeed{
+		Version:     jsonFeedVersion,
+		Title:       feed.Title,
+		Description: feed.Description,
+		HomePageURL: feed.SiteURL,
+		FeedURL:     feed.Link,
+		Language:    "ru",
+		Items:       []jsonFeedItem{},
+	}
+
+	for _, job := range feed.Jobs {
+		document.Items = append(document.Items, jsonFeedItem{
+			ID:            job.Slug,
+			URL:           job.SourceLink,
+			Title:         job.DisplayTitle(),
+			ContentHTML:   job.Content,
+			Summary:       jobSummary(job),
+			DatePublished: job.DatePosted.UTC().Format(time.RFC3339),
+			DateModified:  job.DateParsed.UTC().Format(time.RFC3339),
+			Tags:          jobCategories(job),
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(document)
+}
@@ -0,0 +1,91 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link,omitempty"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Self          *rssSelf  `xml:"atom:link,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+// rssSelf - ссылка ленты на саму себя из пространства имен Atom, рекомендуемая валидаторами RSS
+type rssSelf struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func renderRSS(w io.Writer, feed Feed) error {
+	channel := rssChannel{
+		Title:         feed.Title,
+		Link:          feed.SiteURL,
+		Description:   feed.Description,
+		Language:      "ru",
+		LastBuildDate: feed.Updated.UTC().Format(time.RFC1123Z),
+	}
+	if feed.Link != "" {
+		channel.Self = &rssSelf{Href: feed.Link, Rel: "self", Type: "application/rss+xml"}
+	}
+
+	for _, job := range feed.Jobs {
+		channel.Items = append(channel.Items, rssItem{
+			Title:       job.DisplayTitle(),
+			Link:        job.SourceLink,
+			GUID:        rssGUID{Value: job.Slug},
+			PubDate:     job.DatePosted.UTC().Format(time.RFC1123Z),
+			Description: job.Content,
+			Categories:  jobCategories(job),
+		})
+	}
+
+	return writeXML(w, rssDocument{
+		Version: "2.0",
+		AtomNS:  atomNamespace,
+		Channel: channel,
+	})
+}
+
+// writeXML записывает документ с XML-заголовком и отступами
+func writeXML(w io.Writer, document any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
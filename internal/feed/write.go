@@ -0,0 +1,83 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/utils"
+)
+
+// WriteFiles записывает статические ленты в каталог dir: общие ленты в корень, ленты технологий
+// в technologies/<slug>/, ленты хэштегов в tags/<tag>/ и ленты каналов в channels/<tag>/.
+// Файлы заменяются атомарно, поэтому веб-сервер, раздающий каталог, не отдаст недописанную ленту.
+// Каталоги удаленных технологий, хэштегов и каналов удаляются. Возвращает число записанных файлов
+func (g *Generator) WriteFiles(ctx context.Context, dir string) (int, error) {
+	op := "feed.WriteFiles"
+
+	filters := []Filter{{}}
+
+	technologies, err := g.repository.GetTechnologies(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, tech := range technologies {
+		filters = append(filters, Filter{Technology: tech.Technology})
+	}
+
+	tags, err := g.repository.GetTags(ctx, g.config.Tags)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, tag := range tags {
+		// хэштег без активных вакансий дал бы пустую ленту
+		if tag.Count > 0 {
+			filters = append(filters, Filter{Tag: tag.Tag})
+		}
+	}
+
+	channels, err := g.repository.GetTelegramChannels(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, channel := range channels {
+		filters = append(filters, Filter{Channel: channel.Tag})
+	}
+
+	keep := map[string]map[string]bool{technologiesDir: {}, tagsDir: {}, channelsDir: {}}
+
+	written := 0
+	for _, filter := range filters {
+		if section, name, ok := strings.Cut(filter.Dir(), "/"); ok {
+			keep[section][name] = true
+		}
+
+		for _, format := range Formats {
+			feed, err := g.Build(ctx, filter, format)
+			if err != nil {
+				return written, fmt.Errorf("%s: %w", op, err)
+			}
+
+			var buf bytes.Buffer
+			if err := Render(&buf, feed, format); err != nil {
+				return written, fmt.Errorf("%s: %w", op, err)
+			}
+
+			path := filepath.Join(dir, filepath.FromSlash(filter.Dir()), format.FileName())
+			if err := utils.WriteFileAtomic(path, buf.Bytes()); err != nil {
+				return written, fmt.Errorf("%s: %w", op, err)
+			}
+			written++
+		}
+	}
+
+	for section, names := range keep {
+		if err := utils.RemoveStaleDirs(filepath.Join(dir, section), names); err != nil {
+			return written, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return written, nil
+}
@@ -0,0 +1,45 @@
+package logger
+
+import "time"
+
+// Config задает куда и с каким уровнем пишутся логи
+type Config struct {
+	// Dir - каталог для файла логов
+	Dir string `yaml:"dir"`
+	// File - имя файла логов внутри Dir
+	File string `yaml:"file"`
+	// Level - минимальный уровень для файла и BetterStack
+	Level string `yaml:"level"`
+	// ConsoleLevel - минимальный уровень для вывода в консоль
+	ConsoleLevel string `yaml:"console_level"`
+	// BetterStack - параметры отправки логов в BetterStack
+	BetterStack BetterStackConfig `yaml:"betterstack"`
+}
+
+// BetterStackConfig задает параметры отправки логов в BetterStack.
+// Отправка отключена, если не заданы Token или URL
+type BetterStackConfig struct {
+	Token         string        `yaml:"token"`
+	URL           string        `yaml:"url"`
+	BatchSize     int           `yaml:"batch_size"`
+	FlushInterval time.Duration `yaml:"flush_interval"`
+}
+
+// Enabled сообщает, настроена ли отправка логов в BetterStack
+func (c BetterStackConfig) Enabled() bool {
+	return c.Token != "" && c.URL != ""
+}
+
+// DefaultConfig возвращает конфигурацию логгера по умолчанию
+func DefaultConfig() Config {
+	return Config{
+		Dir:          "logs",
+		File:         "app.log",
+		Level:        "info",
+		ConsoleLevel: "debug",
+		BetterStack: BetterStackConfig{
+			BatchSize:     50,
+			FlushInterval: 10 * time.Second,
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
@@ -9,38 +10,20 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
-func NewLogger(
-	betterStackToken string,
-	betterStackURL string,
-	batchSize int,
-	flushInterval time.Duration,
-
-) (*zap.Logger, error) {
-	// Using relative path to reach project root from internal/logger
-	logDir := filepath.Join("..", "logs")
-	if err := os.MkdirAll(logDir, 0755); err != nil {
-		return nil, err
+// NewLogger создает логгер, который пишет в файл (JSON), в консоль и, если настроено, в BetterStack
+func NewLogger(config Config) (*zap.Logger, error) {
+	level, err := zapcore.ParseLevel(config.Level)
+	if err != nil {
+		return nil, fmt.Errorf("уровень логирования: %w", err)
 	}
 
-	betterStackSink := NewBetterStackSink(
-		betterStackToken,
-		betterStackURL,
-		batchSize,     // batch size - send logs in batches of 10
-		flushInterval, // flush interval - also flush every 30 seconds
-	)
+	consoleLevel, err := zapcore.ParseLevel(config.ConsoleLevel)
+	if err != nil {
+		return nil, fmt.Errorf("уровень логирования в консоль: %w", err)
+	}
 
-	// Create BetterStack-specific encoder config
-	betterStackEncoderConfig := zapcore.EncoderConfig{
-		TimeKey:     "dt",
-		MessageKey:  "message",
-		LevelKey:    "level",
-		LineEnding:  zapcore.DefaultLineEnding,
-		EncodeLevel: zapcore.LowercaseLevelEncoder,
-		EncodeTime: zapcore.TimeEncoder(func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
-			enc.AppendString(t.UTC().Format("2006-01-02 15:04:05 UTC"))
-		}),
-		EncodeDuration: zapcore.SecondsDurationEncoder,
-		EncodeCaller:   zapcore.ShortCallerEncoder,
+	if err := os.MkdirAll(config.Dir, 0755); err != nil {
+		return nil, err
 	}
 
 	// Configure standard encoders
@@ -56,21 +39,47 @@ func NewLogger(
 	consoleEncoder := zapcore.NewConsoleEncoder(debugEncoderConfig)
 
 	// Prepare log file
-	logFile := filepath.Join(logDir, "app.log")
+	logFile := filepath.Join(config.Dir, config.File)
 	fileWriter, err := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create multi-output core
-	core := zapcore.NewTee(
+	cores := []zapcore.Core{
 		// File output (JSON format)
-		zapcore.NewCore(jsonEncoder, zapcore.AddSync(fileWriter), zap.InfoLevel),
+		zapcore.NewCore(jsonEncoder, zapcore.AddSync(fileWriter), level),
 		// Console output (with colors)
-		zapcore.NewCore(consoleEncoder, zapcore.AddSync(os.Stdout), zap.DebugLevel),
+		zapcore.NewCore(consoleEncoder, zapcore.AddSync(os.Stdout), consoleLevel),
+	}
+
+	if config.BetterStack.Enabled() {
+		betterStackSink := NewBetterStackSink(
+			config.BetterStack.Token,
+			config.BetterStack.URL,
+			config.BetterStack.BatchSize,
+			config.BetterStack.FlushInterval,
+		)
+
+		// Create BetterStack-specific encoder config
+		betterStackEncoderConfig := zapcore.EncoderConfig{
+			TimeKey:     "dt",
+			MessageKey:  "message",
+			LevelKey:    "level",
+			LineEnding:  zapcore.DefaultLineEnding,
+			EncodeLevel: zapcore.LowercaseLevelEncoder,
+			EncodeTime: zapcore.TimeEncoder(func(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
+				enc.AppendString(t.UTC().Format("2006-01-02 15:04:05 UTC"))
+			}),
+			EncodeDuration: zapcore.SecondsDurationEncoder,
+			EncodeCaller:   zapcore.ShortCallerEncoder,
+		}
+
 		// BetterStack output (custom JSON format)
-		zapcore.NewCore(zapcore.NewJSONEncoder(betterStackEncoderConfig), zapcore.AddSync(betterStackSink), zap.InfoLevel),
-	)
+		cores = append(cores, zapcore.NewCore(zapcore.NewJSONEncoder(betterStackEncoderConfig), zapcore.AddSync(betterStackSink), level))
+	}
+
+	// Create multi-output core
+	core := zapcore.NewTee(cores...)
 
 	logger := zap.New(core, zap.AddStacktrace(zap.ErrorLevel))
 	return logger, nil
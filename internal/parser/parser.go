@@ -1,10 +1,29 @@
 package parser
 
 import (
+	"context"
+	"errors"
+
 	"github.com/zalhonan/remotejobs-web-scraper/model"
 )
 
+// ErrRateLimited означает, что источник ограничил частоту запросов и парсер прекратил обход
+// до конца запуска. Это состояние источника, а не канала, поэтому сбоем канала не считается
+var ErrRateLimited = errors.New("source is rate limiting requests")
+
 type Parser interface {
-	ParseJobs() (jobs []model.JobRaw, err error)
+	ParseJobs(ctx context.Context) (jobs []model.JobRaw, err error)
 	Name() string
 }
+
+// Revisiter - парсер, который умеет заново собирать недавние посты, чтобы отслеживать их правки и удаление
+type Revisiter interface {
+	Parser
+	RevisitJobs(ctx context.Context) ([]model.RevisitedChannel, error)
+}
+
+// ChannelParser - парсер, который возвращает посты по каналам вместе со сведениями об обходе каждого канала
+type ChannelParser interface {
+	Parser
+	ParseChannels(ctx context.Context) ([]model.ParsedChannel, error)
+}
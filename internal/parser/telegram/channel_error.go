@@ -0,0 +1,17 @@
+package telegram
+
+import "fmt"
+
+// ChannelError описывает ошибку парсинга отдельного канала
+type ChannelError struct {
+	Channel string
+	Err     error
+}
+
+func (e *ChannelError) Error() string {
+	return fmt.Sprintf("channel %s: %v", e.Channel, e.Err)
+}
+
+func (e *ChannelError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,57 @@
+package telegram
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/parser"
+)
+
+// ErrCircuitOpen возвращается, когда t.me начал ограничивать частоту запросов
+// и до конца запуска новые запросы не отправляются
+var ErrCircuitOpen = fmt.Errorf("circuit breaker is open: %w", parser.ErrRateLimited)
+
+// circuitBreaker размыкается после threshold подряд полученных ответов 429
+// и остается разомкнутым до конца текущего запуска парсера
+type circuitBreaker struct {
+	threshold   int
+	mutex       sync.Mutex
+	rateLimited int
+	open        bool
+}
+
+// newCircuitBreaker создает предохранитель; threshold <= 0 отключает его
+func newCircuitBreaker(threshold int) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold}
+}
+
+// Allow сообщает, можно ли отправлять очередной запрос
+func (b *circuitBreaker) Allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return !b.open
+}
+
+// RecordRateLimited учитывает ответ 429 и размыкает предохранитель при достижении порога
+func (b *circuitBreaker) RecordRateLimited() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.rateLimited++
+	if b.rateLimited >= b.threshold {
+		b.open = true
+	}
+}
+
+// RecordSuccess сбрасывает счетчик подряд идущих ответов 429
+func (b *circuitBreaker) RecordSuccess() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.rateLimited = 0
+}
@@ -0,0 +1,51 @@
+package telegram
+
+import "time"
+
+// Config задает параметры работы Telegram-парсера
+type Config struct {
+	// BaseURL - адрес веб-версии Telegram, из которого строятся ссылки вида <BaseURL>/s/<tag>
+	BaseURL string `yaml:"base_url"`
+	// MaxPages ограничивает количество страниц истории канала, просматриваемых за один запуск
+	MaxPages int `yaml:"max_pages"`
+	// NewChannelMaxPages ограничивает глубину пагинации для каналов без сохраненного last_post_id
+	NewChannelMaxPages int `yaml:"new_channel_max_pages"`
+	// Workers - максимальное количество каналов, обрабатываемых параллельно
+	Workers int `yaml:"workers"`
+	// RequestsPerSecond ограничивает частоту запросов к одному хосту для всех воркеров вместе (0 - без ограничения)
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	// PolitenessDelay - пауза между запросами страниц одного канала
+	PolitenessDelay time.Duration `yaml:"politeness_delay"`
+	// RequestTimeout - таймаут одного HTTP-запроса
+	RequestTimeout time.Duration `yaml:"request_timeout"`
+	// MaxRetries - количество повторов запроса при сетевых ошибках, 5xx и 429
+	MaxRetries int `yaml:"max_retries"`
+	// RetryBaseDelay - начальная пауза экспоненциального отката между повторами
+	RetryBaseDelay time.Duration `yaml:"retry_base_delay"`
+	// MaxRetryDelay ограничивает паузу между повторами, в том числе заданную Retry-After
+	MaxRetryDelay time.Duration `yaml:"max_retry_delay"`
+	// CircuitBreakerThreshold - количество подряд полученных ответов 429, после которого
+	// запросы к t.me прекращаются до конца запуска (0 - без ограничения)
+	CircuitBreakerThreshold int `yaml:"circuit_breaker_threshold"`
+	// RevisitWindow - за какой период повторный обход заново собирает посты, чтобы найти правки
+	// и удаления (0 - повторный обход отключен)
+	RevisitWindow time.Duration `yaml:"revisit_window"`
+}
+
+// DefaultConfig возвращает конфигурацию парсера по умолчанию
+func DefaultConfig() Config {
+	return Config{
+		BaseURL:                 "https://t.me",
+		MaxPages:                50,
+		NewChannelMaxPages:      5,
+		Workers:                 4,
+		RequestsPerSecond:       2,
+		PolitenessDelay:         500 * time.Millisecond,
+		RequestTimeout:          15 * time.Second,
+		MaxRetries:              3,
+		RetryBaseDelay:          time.Second,
+		MaxRetryDelay:           30 * time.Second,
+		CircuitBreakerThreshold: 5,
+		RevisitWindow:           72 * time.Hour,
+	}
+}
@@ -0,0 +1,17 @@
+package telegram
+
+import (
+	"context"
+	"net/http"
+)
+
+// contextTransport подставляет контекст запуска в каждый запрос коллектора,
+// так как colly не позволяет передать его напрямую
+type contextTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(t.ctx))
+}
@@ -1,7 +1,9 @@
 package telegram
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 	"time"
@@ -91,15 +93,88 @@ func extractFirstTagContent(html string) string {
 	return ""
 }
 
-func (p *telegramParser) parseChannel(tag string) (jobs []model.JobRaw, err error) {
-	op := "internal.parser.telegram.parseChannel"
+// maxPagesFor возвращает глубину пагинации для канала с учетом того, парсился ли он раньше
+func (p *telegramParser) maxPagesFor(channel model.TelegramChannel) int {
+	maxPages := p.config.MaxPages
+	if channel.LastPostID == nil || *channel.LastPostID == 0 {
+		maxPages = p.config.NewChannelMaxPages
+	}
+
+	if maxPages < 1 {
+		maxPages = 1
+	}
+
+	return maxPages
+}
+
+// parseChannel обходит историю канала страницами по ?before=<id>,
+// пока не дойдет до last_post_id канала или не исчерпает допустимую глубину.
+// Возвращает посты, HTTP-статус последнего ответа и признак того, что обход уже известного канала
+// остановлен ограничением глубины раньше last_post_id: между ними остались несобранные посты.
+// Для нового канала ограничение глубины - штатная остановка
+func (p *telegramParser) parseChannel(ctx context.Context, breaker *circuitBreaker, channel model.TelegramChannel) ([]model.JobRaw, int, bool, error) {
+	var lastPostID int64
+	if channel.LastPostID != nil {
+		lastPostID = *channel.LastPostID
+	}
+
+	jobs, statusCode, exhausted, err := p.fetchChannel(ctx, breaker, channel.Tag, p.maxPagesFor(channel), func(minPostID int64, _ time.Time) bool {
+		return minPostID <= lastPostID+1
+	})
+
+	truncated := exhausted && lastPostID > 0
+	if truncated {
+		p.logger.Warn(
+			"Глубина обхода исчерпана раньше last_post_id, часть постов канала не собрана",
+			zap.String("channel", channel.Tag),
+			zap.Int64("last_post_id", lastPostID),
+			zap.Int("max_pages", p.maxPagesFor(channel)),
+		)
+	}
+
+	return jobs, statusCode, truncated, err
+}
+
+// fetchChannel обходит историю канала tag страницами по ?before=<id>, начиная с последних постов,
+// пока done не вернет true для минимального ID и самой ранней даты постов страницы,
+// пока не закончатся посты или не будет просмотрено maxPages страниц.
+// Возвращает посты в хронологическом порядке, HTTP-статус последнего ответа (0, если ответа не было)
+// и признак exhausted: просмотрено maxPages страниц, а done так и не вернул true
+func (p *telegramParser) fetchChannel(
+	ctx context.Context,
+	breaker *circuitBreaker,
+	tag string,
+	maxPages int,
+	done func(minPostID int64, oldestPosted time.Time) bool,
+) (jobs []model.JobRaw, statusCode int, exhausted bool, err error) {
+	op := "internal.parser.telegram.fetchChannel"
 
 	counter := 0
 
+	// Посты текущей страницы, минимальный ID и самая ранняя дата постов на ней
+	var pageJobs []model.JobRaw
+	var minPostID int64
+	var oldestPosted time.Time
+
+	// ID уже обработанных постов, чтобы страницы не давали дублей
+	seen := make(map[int64]struct{})
+
+	// Сведения о последнем неудачном ответе для логики повторов
+	var lastFailure failedResponse
+
 	c := colly.NewCollector(
 		colly.UserAgent("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36"),
+		// Повторы запрашивают тот же URL, поэтому повторное посещение разрешено
+		colly.AllowURLRevisit(),
 	)
 
+	// Привязываем HTTP-запросы коллектора к контексту, чтобы отмена прерывала их
+	c.WithTransport(&contextTransport{ctx: ctx, base: http.DefaultTransport})
+
+	if p.config.RequestTimeout > 0 {
+		c.SetRequestTimeout(p.config.RequestTimeout)
+	}
+
 	c.OnRequest(func(r *colly.Request) {
 		p.logger.Info(
 			"Visiting URL",
@@ -108,6 +183,12 @@ func (p *telegramParser) parseChannel(tag string) (jobs []model.JobRaw, err erro
 	})
 
 	c.OnError(func(r *colly.Response, err error) {
+		statusCode = r.StatusCode
+		lastFailure.statusCode = r.StatusCode
+		if r.Headers != nil {
+			lastFailure.retryAfter = r.Headers.Get("Retry-After")
+		}
+
 		p.logger.Warn(
 			"Request failed",
 			zap.Int("Status code:", r.StatusCode),
@@ -116,6 +197,7 @@ func (p *telegramParser) parseChannel(tag string) (jobs []model.JobRaw, err erro
 	})
 
 	c.OnResponse(func(r *colly.Response) {
+		statusCode = r.StatusCode
 		p.logger.Info(
 			"Page visited",
 			zap.String("URL", r.Request.URL.String()),
@@ -165,6 +247,23 @@ func (p *telegramParser) parseChannel(tag string) (jobs []model.JobRaw, err erro
 		// Извлекаем ссылку на сообщение
 		messageLink, _ := infoBlock.Find("a.tgme_widget_message_date").Attr("href")
 
+		// Определяем ID поста по ссылке, а если ее нет - по атрибуту data-post
+		postID, err := utils.PostIDFromLink(messageLink)
+		if err != nil {
+			postID, err = utils.PostIDFromLink(e.Attr("data-post"))
+		}
+
+		if err == nil {
+			if minPostID == 0 || postID < minPostID {
+				minPostID = postID
+			}
+
+			if _, exists := seen[postID]; exists {
+				return
+			}
+			seen[postID] = struct{}{}
+		}
+
 		counter++
 
 		// Parse the dateTime string into a time.Time value
@@ -174,7 +273,11 @@ func (p *telegramParser) parseChannel(tag string) (jobs []model.JobRaw, err erro
 			parsedTime = time.Now()
 		}
 
-		jobs = append(jobs, model.JobRaw{
+		if oldestPosted.IsZero() || parsedTime.Before(oldestPosted) {
+			oldestPosted = parsedTime
+		}
+
+		pageJobs = append(pageJobs, model.JobRaw{
 			Content:     htmlContent,
 			Title:       title,
 			ContentPure: contentPure,
@@ -191,20 +294,54 @@ func (p *telegramParser) parseChannel(tag string) (jobs []model.JobRaw, err erro
 		)
 	})
 
-	channel := fmt.Sprintf("https://t.me/s/%s", tag)
+	channelURL := fmt.Sprintf("%s/s/%s", p.config.BaseURL, tag)
+	pageURL := channelURL
 
-	error := c.Visit(channel)
+	exhausted = true
+	for page := 0; page < maxPages; page++ {
+		pageJobs = nil
+		minPostID = 0
+		oldestPosted = time.Time{}
 
-	if error != nil {
-		return nil, fmt.Errorf("%s: %w", op, error)
+		// Между страницами одного канала выдерживаем паузу
+		if page > 0 {
+			if err := sleepContext(ctx, p.config.PolitenessDelay); err != nil {
+				return jobs, statusCode, false, fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		if err := p.visitWithRetry(ctx, breaker, c, pageURL, &lastFailure); err != nil {
+			// Ошибка на первой странице означает, что канал не получен вовсе
+			if page == 0 {
+				return nil, statusCode, false, fmt.Errorf("%s: %w", op, err)
+			}
+
+			// Возвращаем уже собранные посты вместе с ошибкой
+			p.logger.Warn(
+				"Pagination stopped on error",
+				zap.String("URL", pageURL),
+				zap.Error(err),
+			)
+			return jobs, statusCode, false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Страницы идут от новых постов к старым, поэтому более старые посты ставим в начало
+		jobs = append(pageJobs, jobs...)
+
+		// Дошли до начала канала или до постов, которые не нужно собирать
+		if minPostID == 0 || done(minPostID, oldestPosted) {
+			exhausted = false
+			break
+		}
+
+		pageURL = fmt.Sprintf("%s?before=%d", channelURL, minPostID)
 	}
 
 	p.logger.Info(
 		"Messages parsed",
-		zap.String("URL", channel),
+		zap.String("URL", channelURL),
 		zap.Int("Processed", counter),
 	)
 
-	return jobs, nil
-
+	return jobs, statusCode, exhausted, nil
 }
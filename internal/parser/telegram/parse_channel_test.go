@@ -0,0 +1,193 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+// renderChannelPage формирует HTML страницы веб-версии канала с постами в диапазоне [from, to]
+func renderChannelPage(tag string, from, to int64) string {
+	posted := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+	return renderDatedChannelPage(tag, from, to, func(int64) time.Time { return posted })
+}
+
+// renderDatedChannelPage формирует HTML страницы канала с постами в диапазоне [from, to] и датами posted(id)
+func renderDatedChannelPage(tag string, from, to int64, posted func(id int64) time.Time) string {
+	var sb strings.Builder
+	sb.WriteString("<html><body>")
+	for id := from; id <= to; id++ {
+		fmt.Fprintf(&sb, `<div class="tgme_widget_message" data-post="%[1]s/%[2]d">
+<div class="tgme_widget_message_text js-message_text"><b>Вакансия номер %[2]d</b><br/>Go разработчик</div>
+<div class="tgme_widget_message_info short js-message_info">
+<a class="tgme_widget_message_date" href="https://t.me/%[1]s/%[2]d"><time datetime="%[3]s"></time></a>
+</div>
+</div>`, tag, id, posted(id).Format(time.RFC3339))
+	}
+	sb.WriteString("</body></html>")
+	return sb.String()
+}
+
+// newChannelServer поднимает тестовый сервер, отдающий историю канала страницами по pageSize постов
+func newChannelServer(t *testing.T, tag string, newestID, pageSize int64) (*httptest.Server, *[]string) {
+	var visited []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		visited = append(visited, r.URL.RequestURI())
+
+		to := newestID
+		if before := r.URL.Query().Get("before"); before != "" {
+			beforeID, err := strconv.ParseInt(before, 10, 64)
+			require.NoError(t, err)
+			to = beforeID - 1
+		}
+
+		from := to - pageSize + 1
+		if from < 1 {
+			from = 1
+		}
+
+		fmt.Fprint(w, renderChannelPage(tag, from, to))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &visited
+}
+
+// testConfig возвращает конфигурацию парсера для тестового сервера без задержек между запросами
+func testConfig(baseURL string) Config {
+	config := DefaultConfig()
+	config.BaseURL = baseURL
+	config.RequestsPerSecond = 0
+	config.PolitenessDelay = 0
+	config.RetryBaseDelay = time.Millisecond
+	config.MaxRetryDelay = 10 * time.Millisecond
+	return config
+}
+
+// TestParseChannelPagination проверяет обход истории канала по ?before=<id>
+func TestParseChannelPagination(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := context.Background()
+
+	t.Run("пагинация до last_post_id", func(t *testing.T) {
+		// GIVEN: Канал с 50 постами, из которых первые 12 уже сохранены
+		server, visited := newChannelServer(t, "test_channel", 50, 20)
+
+		config := testConfig(server.URL)
+		parser := NewTelegramParser(test.NewMockRepository(logger), logger, config)
+
+		channel := test.CreateMockTelegramChannel(1, "test_channel", 12, 12)
+
+		// WHEN: Парсим канал
+		jobs, _, truncated, err := parser.parseChannel(ctx, newCircuitBreaker(0), channel)
+
+		// THEN: Получены все посты с 11 по 50 в хронологическом порядке
+		require.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, []string{
+			"/s/test_channel",
+			"/s/test_channel?before=31",
+		}, *visited)
+		require.Len(t, jobs, 40)
+		assert.Equal(t, "https://t.me/test_channel/11", jobs[0].SourceLink)
+		assert.Equal(t, "https://t.me/test_channel/50", jobs[len(jobs)-1].SourceLink)
+	})
+
+	t.Run("остановка на первой странице для актуального канала", func(t *testing.T) {
+		// GIVEN: Канал, у которого сохранены все посты, кроме последних пяти
+		server, visited := newChannelServer(t, "test_channel", 50, 20)
+
+		config := testConfig(server.URL)
+		parser := NewTelegramParser(test.NewMockRepository(logger), logger, config)
+
+		channel := test.CreateMockTelegramChannel(1, "test_channel", 45, 45)
+
+		// WHEN: Парсим канал
+		jobs, _, truncated, err := parser.parseChannel(ctx, newCircuitBreaker(0), channel)
+
+		// THEN: Запрошена только первая страница
+		require.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, []string{"/s/test_channel"}, *visited)
+		assert.Len(t, jobs, 20)
+	})
+
+	t.Run("ограничение глубины для нового канала", func(t *testing.T) {
+		// GIVEN: Новый канал без last_post_id и ограничение в две страницы
+		server, visited := newChannelServer(t, "test_channel", 100, 20)
+
+		config := testConfig(server.URL)
+		config.NewChannelMaxPages = 2
+		parser := NewTelegramParser(test.NewMockRepository(logger), logger, config)
+
+		channel := model.TelegramChannel{ID: 1, Tag: "test_channel"}
+
+		// WHEN: Парсим канал
+		jobs, _, truncated, err := parser.parseChannel(ctx, newCircuitBreaker(0), channel)
+
+		// THEN: Просмотрено не больше двух страниц, для нового канала это не обрыв обхода
+		require.NoError(t, err)
+		assert.Len(t, *visited, 2)
+		assert.Len(t, jobs, 40)
+		assert.Equal(t, "https://t.me/test_channel/61", jobs[0].SourceLink)
+		assert.False(t, truncated)
+	})
+
+	t.Run("исчерпание глубины для известного канала", func(t *testing.T) {
+		// GIVEN: Канал с сохраненными постами по 12-й и ограничение в две страницы
+		server, visited := newChannelServer(t, "test_channel", 100, 20)
+
+		config := testConfig(server.URL)
+		config.MaxPages = 2
+		parser := NewTelegramParser(test.NewMockRepository(logger), logger, config)
+
+		channel := test.CreateMockTelegramChannel(1, "test_channel", 12, 12)
+
+		// WHEN: Парсим канал
+		jobs, _, truncated, err := parser.parseChannel(ctx, newCircuitBreaker(0), channel)
+
+		// THEN: Собраны две страницы, обход отмечен как оборванный до last_post_id
+		require.NoError(t, err)
+		assert.Len(t, *visited, 2)
+		assert.Len(t, jobs, 40)
+		assert.True(t, truncated)
+	})
+
+	t.Run("ошибка на второй странице", func(t *testing.T) {
+		// GIVEN: Канал, вторая страница которого отдает ошибку
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("before") != "" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprint(w, renderChannelPage("test_channel", 31, 50))
+		}))
+		t.Cleanup(server.Close)
+
+		config := testConfig(server.URL)
+		config.MaxRetries = 0
+		parser := NewTelegramParser(test.NewMockRepository(logger), logger, config)
+
+		channel := test.CreateMockTelegramChannel(1, "test_channel", 12, 12)
+
+		// WHEN: Парсим канал
+		jobs, _, truncated, err := parser.parseChannel(ctx, newCircuitBreaker(0), channel)
+
+		// THEN: Посты первой страницы возвращены вместе с ошибкой
+		require.Error(t, err)
+		assert.Len(t, jobs, 20)
+		assert.False(t, truncated)
+	})
+}
@@ -1,36 +1,121 @@
 package telegram
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/zalhonan/remotejobs-web-scraper/model"
 	"go.uber.org/zap"
 )
 
-func (p *telegramParser) ParseJobs() (jobs []model.JobRaw, err error) {
-	op := "internal.parser.telegram.ParseJobs"
+// ParseJobs парсит все каналы и возвращает их вакансии одним списком в порядке следования каналов
+func (p *telegramParser) ParseJobs(ctx context.Context) (jobs []model.JobRaw, err error) {
+	channels, err := p.ParseChannels(ctx)
 
-	channels, error := p.repository.GetTelegramChannels()
+	for _, channel := range channels {
+		jobs = append(jobs, channel.Jobs...)
+	}
+
+	return jobs, err
+}
 
-	if error != nil {
-		return nil, fmt.Errorf("%s: %w", op, error)
+// ParseChannels парсит все каналы пулом из config.Workers воркеров.
+// Ошибки отдельных каналов записываются в их результаты и собираются в общую ошибку
+// из *ChannelError, не отменяя результатов остальных каналов.
+// При отмене ctx новые каналы не запускаются и в результат не попадают,
+// а уже собранные вакансии возвращаются вместе с ошибкой
+func (p *telegramParser) ParseChannels(ctx context.Context) ([]model.ParsedChannel, error) {
+	op := "internal.parser.telegram.ParseChannels"
+
+	channels, err := p.repository.GetTelegramChannels(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	for _, channel := range channels {
+	results := make([]model.ParsedChannel, len(channels))
 
-		parsedJobs, error := p.parseChannel(channel.Tag)
+	err = p.forEachChannel(ctx, channels, func(i int, breaker *circuitBreaker) error {
+		result := model.ParsedChannel{Channel: channels[i].Tag, StartedAt: time.Now()}
+		result.Jobs, result.HTTPStatus, result.Truncated, result.Err = p.parseChannel(ctx, breaker, channels[i])
+		result.FinishedAt = time.Now()
 
-		if error != nil {
-			p.logger.Warn(
-				"Error parsing jobs from channel",
-				zap.String("Channel", channel.Tag),
-				zap.Error(error),
-			)
+		results[i] = result
+		return result.Err
+	})
+
+	parsed := make([]model.ParsedChannel, 0, len(results))
+	for _, result := range results {
+		// Каналы, до которых не дошла очередь из-за отмены, пропускаем
+		if !result.StartedAt.IsZero() {
+			parsed = append(parsed, result)
 		}
+	}
+
+	if err != nil {
+		return parsed, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return parsed, nil
+}
+
+// forEachChannel вызывает parse для каждого канала пулом из config.Workers воркеров.
+// Все каналы одного вызова делят предохранитель breaker, который создается на каждый вызов,
+// поэтому одновременные сбор и повторный обход не сбрасывают предохранители друг друга.
+// Ошибки каналов оборачиваются в *ChannelError и объединяются вместе с ошибкой отмены ctx.
+// При отмене ctx новые каналы не запускаются
+func (p *telegramParser) forEachChannel(ctx context.Context, channels []model.TelegramChannel, parse func(i int, breaker *circuitBreaker) error) error {
+	breaker := newCircuitBreaker(p.config.CircuitBreakerThreshold)
+
+	workers := p.config.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(channels) {
+		workers = len(channels)
+	}
+
+	channelErrors := make([]error, len(channels))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				if parseErr := parse(i, breaker); parseErr != nil {
+					p.logger.Warn(
+						"Error parsing jobs from channel",
+						zap.String("Channel", channels[i].Tag),
+						zap.Error(parseErr),
+					)
+					channelErrors[i] = &ChannelError{Channel: channels[i].Tag, Err: parseErr}
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for i := range channels {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case indexes <- i:
+		}
+	}
+	close(indexes)
 
-		jobs = append(jobs, parsedJobs...)
+	wg.Wait()
 
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		channelErrors = append(channelErrors, ctxErr)
 	}
 
-	return jobs, nil
+	return errors.Join(channelErrors...)
 }
@@ -0,0 +1,214 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestParseJobsWorkerPool проверяет параллельный обход каналов и сбор ошибок по каналам
+func TestParseJobsWorkerPool(t *testing.T) {
+	// GIVEN: Сервер с тремя каналами, один из которых недоступен
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+
+		// Задержка, чтобы запросы воркеров пересекались во времени
+		time.Sleep(50 * time.Millisecond)
+
+		tag := strings.TrimPrefix(r.URL.Path, "/s/")
+		switch tag {
+		case "channel_a":
+			fmt.Fprint(w, renderChannelPage(tag, 1, 3))
+		case "channel_b":
+			fmt.Fprint(w, renderChannelPage(tag, 1, 2))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	logger := zaptest.NewLogger(t)
+	ctx := context.Background()
+	mockRepo := test.NewMockRepository(logger)
+	mockRepo.TelegramChannels = []model.TelegramChannel{
+		test.CreateMockTelegramChannel(1, "channel_a", 0, 0),
+		test.CreateMockTelegramChannel(2, "channel_missing", 0, 0),
+		test.CreateMockTelegramChannel(3, "channel_b", 0, 0),
+	}
+
+	config := testConfig(server.URL)
+	config.Workers = 3
+	parser := NewTelegramParser(mockRepo, logger, config)
+
+	// WHEN: Парсим все каналы
+	jobs, err := parser.ParseJobs(ctx)
+
+	// THEN: Вакансии доступных каналов объединены в порядке каналов
+	require.Len(t, jobs, 5)
+	assert.Equal(t, "https://t.me/channel_a/1", jobs[0].SourceLink)
+	assert.Equal(t, "https://t.me/channel_a/3", jobs[2].SourceLink)
+	assert.Equal(t, "https://t.me/channel_b/1", jobs[3].SourceLink)
+
+	// THEN: Ошибка недоступного канала возвращена вызывающему коду
+	require.Error(t, err)
+	var channelErr *ChannelError
+	require.True(t, errors.As(err, &channelErr))
+	assert.Equal(t, "channel_missing", channelErr.Channel)
+
+	// THEN: Каналы обрабатывались параллельно
+	assert.Greater(t, atomic.LoadInt32(&maxInFlight), int32(1))
+}
+
+// TestParseChannels проверяет сведения об обходе каждого канала
+func TestParseChannels(t *testing.T) {
+	// GIVEN: Сервер с доступным и недоступным каналом
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tag := strings.TrimPrefix(r.URL.Path, "/s/"); tag == "channel_a" {
+			fmt.Fprint(w, renderChannelPage(tag, 1, 3))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	logger := zaptest.NewLogger(t)
+	mockRepo := test.NewMockRepository(logger)
+	mockRepo.TelegramChannels = []model.TelegramChannel{
+		test.CreateMockTelegramChannel(1, "channel_a", 0, 0),
+		test.CreateMockTelegramChannel(2, "channel_missing", 0, 0),
+	}
+
+	parser := NewTelegramParser(mockRepo, logger, testConfig(server.URL))
+
+	// WHEN: Парсим каналы
+	channels, err := parser.ParseChannels(context.Background())
+
+	// THEN: Для каждого канала возвращены посты, HTTP-статус и ошибка
+	require.Error(t, err)
+	require.Len(t, channels, 2)
+
+	assert.Equal(t, "channel_a", channels[0].Channel)
+	assert.Len(t, channels[0].Jobs, 3)
+	assert.Equal(t, http.StatusOK, channels[0].HTTPStatus)
+	assert.NoError(t, channels[0].Err)
+	assert.False(t, channels[0].FinishedAt.Before(channels[0].StartedAt))
+
+	assert.Equal(t, "channel_missing", channels[1].Channel)
+	assert.Empty(t, channels[1].Jobs)
+	assert.Equal(t, http.StatusNotFound, channels[1].HTTPStatus)
+	assert.Error(t, channels[1].Err)
+}
+
+// TestParseJobsCancellation проверяет прерывание парсинга при отмене контекста
+func TestParseJobsCancellation(t *testing.T) {
+	// GIVEN: Сервер, который отвечает дольше, чем разрешено работать парсеру
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	logger := zaptest.NewLogger(t)
+	mockRepo := test.NewMockRepository(logger)
+	mockRepo.TelegramChannels = []model.TelegramChannel{
+		test.CreateMockTelegramChannel(1, "channel_a", 0, 0),
+		test.CreateMockTelegramChannel(2, "channel_b", 0, 0),
+	}
+
+	config := testConfig(server.URL)
+	config.Workers = 1
+	parser := NewTelegramParser(mockRepo, logger, config)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// WHEN: Парсим каналы
+	start := time.Now()
+	jobs, err := parser.ParseJobs(ctx)
+
+	// THEN: Парсинг завершился по отмене контекста, не дожидаясь ответа сервера
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Empty(t, jobs)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
+
+// TestParseChannelsCancelledMidPagination проверяет канал, прерванный отменой посреди обхода страниц
+func TestParseChannelsCancelledMidPagination(t *testing.T) {
+	// GIVEN: Сервер отдает первую страницу, а на запросе второй запуск отменяется
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("before") != "" {
+			cancel()
+			<-r.Context().Done()
+			return
+		}
+		fmt.Fprint(w, renderChannelPage("channel_a", 31, 50))
+	}))
+	defer server.Close()
+
+	logger := zaptest.NewLogger(t)
+	mockRepo := test.NewMockRepository(logger)
+	mockRepo.TelegramChannels = []model.TelegramChannel{
+		test.CreateMockTelegramChannel(1, "channel_a", 12, 12),
+	}
+
+	parser := NewTelegramParser(mockRepo, logger, testConfig(server.URL))
+
+	// WHEN: Парсим каналы
+	channels, err := parser.ParseChannels(ctx)
+
+	// THEN: Посты первой страницы возвращены, а канал отмечен как неполный,
+	// чтобы сохранение не сдвинуло last_post_id за несобранные посты
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, channels, 1)
+	assert.Len(t, channels[0].Jobs, 20)
+	assert.ErrorIs(t, channels[0].Err, context.Canceled)
+	assert.True(t, channels[0].Incomplete())
+}
+
+// TestHostLimiter проверяет, что ограничитель разносит запросы к одному хосту по времени
+func TestHostLimiter(t *testing.T) {
+	// GIVEN: Ограничитель на 20 запросов в секунду
+	limiter := newHostLimiter(20)
+	ctx := context.Background()
+
+	// WHEN: Делаем три запроса подряд к одному хосту и один к другому
+	start := time.Now()
+	limiter.Wait(ctx, "t.me")
+	limiter.Wait(ctx, "t.me")
+	limiter.Wait(ctx, "example.com")
+	limiter.Wait(ctx, "t.me")
+	elapsed := time.Since(start)
+
+	// THEN: Запросы к одному хосту заняли не меньше двух интервалов
+	// Верхнюю границу не проверяем: под нагрузкой и с -race она зависит от планировщика
+	assert.GreaterOrEqual(t, elapsed, 100*time.Millisecond)
+}
@@ -0,0 +1,64 @@
+package telegram
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// hostLimiter ограничивает частоту запросов к каждому хосту единым интервалом,
+// общим для всех воркеров парсера
+type hostLimiter struct {
+	interval time.Duration
+	mutex    sync.Mutex
+	next     map[string]time.Time
+}
+
+// newHostLimiter создает ограничитель на requestsPerSecond запросов в секунду к одному хосту
+func newHostLimiter(requestsPerSecond float64) *hostLimiter {
+	var interval time.Duration
+	if requestsPerSecond > 0 {
+		interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+
+	return &hostLimiter{
+		interval: interval,
+		next:     make(map[string]time.Time),
+	}
+}
+
+// Wait блокирует выполнение, пока для хоста не наступит очередной разрешенный слот
+// или не будет отменен контекст
+func (l *hostLimiter) Wait(ctx context.Context, host string) error {
+	if l.interval <= 0 {
+		return ctx.Err()
+	}
+
+	l.mutex.Lock()
+	now := time.Now()
+	slot := l.next[host]
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next[host] = slot.Add(l.interval)
+	l.mutex.Unlock()
+
+	return sleepContext(ctx, time.Until(slot))
+}
+
+// sleepContext приостанавливает выполнение на delay с учетом отмены контекста
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,138 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gocolly/colly"
+	"go.uber.org/zap"
+)
+
+// failedResponse хранит сведения о последнем неудачном ответе коллектора
+type failedResponse struct {
+	statusCode int
+	retryAfter string
+}
+
+// isRetryable определяет, имеет ли смысл повторять запрос с таким статусом.
+// Нулевой статус означает сетевую ошибку или таймаут
+func isRetryable(statusCode int) bool {
+	return statusCode == 0 ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
+// parseRetryAfter разбирает заголовок Retry-After в секундах или в формате HTTP-даты
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// backoffDelay вычисляет паузу перед повтором attempt (с нуля): экспоненциальный рост
+// от RetryBaseDelay до MaxRetryDelay со случайным разбросом в диапазоне [d/2, d]
+func (p *telegramParser) backoffDelay(attempt int) time.Duration {
+	delay := p.config.RetryBaseDelay << attempt
+	if delay <= 0 || delay > p.config.MaxRetryDelay {
+		delay = p.config.MaxRetryDelay
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// visitWithRetry запрашивает страницу, повторяя запрос при временных ошибках.
+// lastFailure заполняется обработчиком OnError коллектора, ответы 429 учитываются в breaker
+func (p *telegramParser) visitWithRetry(ctx context.Context, breaker *circuitBreaker, c *colly.Collector, pageURL string, lastFailure *failedResponse) error {
+	op := "internal.parser.telegram.visitWithRetry"
+
+	host := ""
+	if parsedURL, err := url.Parse(pageURL); err == nil {
+		host = parsedURL.Host
+	}
+
+	attempts := p.config.MaxRetries + 1
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	made := 0
+	for attempt := 0; attempt < attempts; attempt++ {
+		if !breaker.Allow() {
+			return fmt.Errorf("%s: %w", op, ErrCircuitOpen)
+		}
+
+		if err := p.limiter.Wait(ctx, host); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		*lastFailure = failedResponse{}
+		made++
+		err = c.Visit(pageURL)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("%s: %w", op, ctxErr)
+		}
+		if err == nil {
+			breaker.RecordSuccess()
+			return nil
+		}
+
+		if lastFailure.statusCode == http.StatusTooManyRequests {
+			breaker.RecordRateLimited()
+		}
+
+		if !isRetryable(lastFailure.statusCode) || attempt == attempts-1 {
+			break
+		}
+
+		delay := p.backoffDelay(attempt)
+		if retryAfter, ok := parseRetryAfter(lastFailure.retryAfter, time.Now()); ok {
+			delay = retryAfter
+			if p.config.MaxRetryDelay > 0 && delay > p.config.MaxRetryDelay {
+				delay = p.config.MaxRetryDelay
+			}
+		}
+
+		p.logger.Warn(
+			"Retrying request",
+			zap.String("URL", pageURL),
+			zap.Int("Status code", lastFailure.statusCode),
+			zap.Int("Attempt", attempt+1),
+			zap.Duration("Delay", delay),
+			zap.Error(err),
+		)
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return fmt.Errorf("%s: status %d after %d attempt(s): %w", op, lastFailure.statusCode, made, err)
+}
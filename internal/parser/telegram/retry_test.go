@@ -0,0 +1,172 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+// newFlakyServer поднимает сервер, который отвечает статусом status на первые failures запросов
+func newFlakyServer(t *testing.T, failures int32, status int, retryAfter string) (*httptest.Server, *int32) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+
+		fmt.Fprint(w, renderChannelPage("test_channel", 1, 3))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+// TestParseChannelRetry проверяет повторы запросов при временных ошибках
+func TestParseChannelRetry(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := context.Background()
+	channel := model.TelegramChannel{ID: 1, Tag: "test_channel"}
+
+	t.Run("повтор после ошибок 5xx", func(t *testing.T) {
+		// GIVEN: Сервер дважды отвечает 503, затем отдает страницу
+		server, requests := newFlakyServer(t, 2, http.StatusServiceUnavailable, "")
+		parser := NewTelegramParser(test.NewMockRepository(logger), logger, testConfig(server.URL))
+
+		// WHEN: Парсим канал
+		jobs, status, _, err := parser.parseChannel(ctx, newCircuitBreaker(0), channel)
+
+		// THEN: Страница получена с третьей попытки
+		require.NoError(t, err)
+		assert.Len(t, jobs, 3)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+	})
+
+	t.Run("без повторов при 404", func(t *testing.T) {
+		// GIVEN: Сервер отвечает 404
+		server, requests := newFlakyServer(t, 10, http.StatusNotFound, "")
+		parser := NewTelegramParser(test.NewMockRepository(logger), logger, testConfig(server.URL))
+
+		// WHEN: Парсим канал
+		jobs, status, _, err := parser.parseChannel(ctx, newCircuitBreaker(0), channel)
+
+		// THEN: Ошибка возвращена после единственной попытки
+		require.Error(t, err)
+		assert.Nil(t, jobs)
+		assert.Equal(t, http.StatusNotFound, status)
+		assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+	})
+
+	t.Run("исчерпание попыток", func(t *testing.T) {
+		// GIVEN: Сервер постоянно отвечает 502
+		server, requests := newFlakyServer(t, 10, http.StatusBadGateway, "")
+		config := testConfig(server.URL)
+		config.MaxRetries = 2
+		parser := NewTelegramParser(test.NewMockRepository(logger), logger, config)
+
+		// WHEN: Парсим канал
+		_, _, _, err := parser.parseChannel(ctx, newCircuitBreaker(0), channel)
+
+		// THEN: Сделано MaxRetries+1 попыток, ошибка содержит статус
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "status 502 after 3 attempt(s)")
+		assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+	})
+
+	t.Run("размыкание предохранителя на 429", func(t *testing.T) {
+		// GIVEN: Сервер постоянно отвечает 429 с Retry-After и порог предохранителя 2
+		server, requests := newFlakyServer(t, 100, http.StatusTooManyRequests, "0")
+		config := testConfig(server.URL)
+		config.MaxRetries = 5
+		config.CircuitBreakerThreshold = 2
+		parser := NewTelegramParser(test.NewMockRepository(logger), logger, config)
+
+		// WHEN: Парсим канал дважды в пределах одного запуска
+		breaker := newCircuitBreaker(config.CircuitBreakerThreshold)
+		_, _, _, firstErr := parser.parseChannel(ctx, breaker, channel)
+		_, _, _, secondErr := parser.parseChannel(ctx, breaker, channel)
+
+		// THEN: После двух ответов 429 запросы прекращаются
+		assert.True(t, errors.Is(firstErr, ErrCircuitOpen))
+		assert.True(t, errors.Is(secondErr, ErrCircuitOpen))
+		assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+	})
+
+	t.Run("у каждого запуска свой предохранитель", func(t *testing.T) {
+		// GIVEN: Сервер один раз отвечает 429, порог предохранителя 1
+		server, requests := newFlakyServer(t, 1, http.StatusTooManyRequests, "0")
+		config := testConfig(server.URL)
+		config.CircuitBreakerThreshold = 1
+		mockRepo := test.NewMockRepository(logger)
+		mockRepo.TelegramChannels = []model.TelegramChannel{channel}
+		parser := NewTelegramParser(mockRepo, logger, config)
+
+		// WHEN: Запускаем сбор дважды
+		_, firstErr := parser.ParseChannels(ctx)
+		second, secondErr := parser.ParseChannels(ctx)
+
+		// THEN: Разомкнутый в первом запуске предохранитель не мешает второму
+		assert.True(t, errors.Is(firstErr, ErrCircuitOpen))
+		require.NoError(t, secondErr)
+		require.Len(t, second, 1)
+		assert.Len(t, second[0].Jobs, 3)
+		assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{name: "Секунды", value: "120", expected: 2 * time.Minute, ok: true},
+		{name: "HTTP-дата", value: "Thu, 01 May 2025 10:00:30 GMT", expected: 30 * time.Second, ok: true},
+		{name: "Дата в прошлом", value: "Thu, 01 May 2025 09:00:00 GMT", expected: 0, ok: true},
+		{name: "Пустое значение", value: "", ok: false},
+		{name: "Некорректное значение", value: "soon", ok: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			delay, ok := parseRetryAfter(tc.value, now)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, delay)
+		})
+	}
+}
+
+func TestBackoffDelay(t *testing.T) {
+	// GIVEN: Парсер с базовой паузой 100мс и потолком 1с
+	config := DefaultConfig()
+	config.RetryBaseDelay = 100 * time.Millisecond
+	config.MaxRetryDelay = time.Second
+	parser := &telegramParser{config: config}
+
+	// THEN: Пауза растет экспоненциально, укладывается в [d/2, d] и не превышает потолок
+	for attempt, expected := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		expected *= time.Millisecond
+		delay := parser.backoffDelay(attempt)
+		assert.GreaterOrEqual(t, delay, expected/2)
+		assert.LessOrEqual(t, delay, expected)
+	}
+}
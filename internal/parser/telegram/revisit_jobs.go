@@ -0,0 +1,80 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/utils"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// RevisitJobs заново собирает посты каналов, опубликованные за config.RevisitWindow,
+// чтобы найти отредактированные и удаленные вакансии. Каналы, которые еще не собирались, пропускаются.
+// Для каналов с ошибкой обхода возвращаются собранные посты без диапазона, чтобы не пометить
+// удаленными посты с непросмотренных страниц
+func (p *telegramParser) RevisitJobs(ctx context.Context) ([]model.RevisitedChannel, error) {
+	op := "internal.parser.telegram.RevisitJobs"
+
+	if p.config.RevisitWindow <= 0 {
+		return nil, nil
+	}
+
+	channels, err := p.repository.GetTelegramChannels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	since := time.Now().Add(-p.config.RevisitWindow)
+	results := make([]model.RevisitedChannel, len(channels))
+
+	err = p.forEachChannel(ctx, channels, func(i int, breaker *circuitBreaker) error {
+		channel := channels[i]
+		if channel.LastPostID == nil || *channel.LastPostID == 0 {
+			return nil
+		}
+
+		jobs, _, _, fetchErr := p.fetchChannel(ctx, breaker, channel.Tag, p.config.MaxPages, func(_ int64, oldestPosted time.Time) bool {
+			return oldestPosted.Before(since)
+		})
+
+		results[i] = model.RevisitedChannel{Channel: channel.Tag, Jobs: jobs}
+		if fetchErr == nil {
+			results[i].FromPostID, results[i].ToPostID = postIDRange(jobs)
+		}
+
+		return fetchErr
+	})
+
+	var revisited []model.RevisitedChannel
+	for _, result := range results {
+		if len(result.Jobs) > 0 {
+			revisited = append(revisited, result)
+		}
+	}
+
+	if err != nil {
+		return revisited, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return revisited, nil
+}
+
+// postIDRange возвращает минимальный и максимальный ID постов
+func postIDRange(jobs []model.JobRaw) (from, to int64) {
+	for _, job := range jobs {
+		postID, err := utils.PostIDFromLink(job.SourceLink)
+		if err != nil {
+			continue
+		}
+
+		if from == 0 || postID < from {
+			from = postID
+		}
+		if postID > to {
+			to = postID
+		}
+	}
+
+	return from, to
+}
@@ -0,0 +1,87 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestRevisitJobs проверяет повторный обход постов канала за окно RevisitWindow
+func TestRevisitJobs(t *testing.T) {
+	// GIVEN: Канал со 100 постами, публикуемыми раз в час, страницы по 20 постов
+	const newestID, pageSize = 100, 20
+	now := time.Now()
+	posted := func(id int64) time.Time {
+		return now.Add(-time.Duration(newestID-id) * time.Hour)
+	}
+
+	var visited []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		visited = append(visited, r.URL.RequestURI())
+
+		to := int64(newestID)
+		if before := r.URL.Query().Get("before"); before != "" {
+			beforeID, err := strconv.ParseInt(before, 10, 64)
+			require.NoError(t, err)
+			to = beforeID - 1
+		}
+
+		fmt.Fprint(w, renderDatedChannelPage("test_channel", max(to-pageSize+1, 1), to, posted))
+	}))
+	defer server.Close()
+
+	logger := zaptest.NewLogger(t)
+	mockRepo := test.NewMockRepository(logger)
+	mockRepo.TelegramChannels = []model.TelegramChannel{
+		test.CreateMockTelegramChannel(1, "test_channel", newestID, newestID),
+		// Канал, который еще не собирался, повторно не обходится
+		{ID: 2, Tag: "new_channel"},
+	}
+
+	config := testConfig(server.URL)
+	config.RevisitWindow = 30 * time.Hour
+	parser := NewTelegramParser(mockRepo, logger, config)
+
+	// WHEN: Выполняем повторный обход
+	revisited, err := parser.RevisitJobs(context.Background())
+
+	// THEN: Просмотрены страницы, пока не встретились посты старше окна
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/s/test_channel",
+		"/s/test_channel?before=81",
+	}, visited)
+
+	// THEN: Возвращен диапазон всех просмотренных постов
+	require.Len(t, revisited, 1)
+	assert.Equal(t, "test_channel", revisited[0].Channel)
+	assert.Equal(t, int64(61), revisited[0].FromPostID)
+	assert.Equal(t, int64(100), revisited[0].ToPostID)
+	assert.Len(t, revisited[0].Jobs, 40)
+}
+
+// TestRevisitJobsDisabled проверяет, что нулевое окно отключает повторный обход
+func TestRevisitJobsDisabled(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	mockRepo := test.NewMockRepository(logger)
+	mockRepo.TelegramChannels = []model.TelegramChannel{test.CreateMockTelegramChannel(1, "test_channel", 10, 10)}
+
+	config := testConfig("http://127.0.0.1:0")
+	config.RevisitWindow = 0
+	parser := NewTelegramParser(mockRepo, logger, config)
+
+	revisited, err := parser.RevisitJobs(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, revisited)
+}
@@ -1,8 +1,6 @@
 package telegram
 
 import (
-	"context"
-
 	"github.com/zalhonan/remotejobs-web-scraper/internal/repository"
 	"go.uber.org/zap"
 )
@@ -10,17 +8,19 @@ import (
 type telegramParser struct {
 	repository repository.JobsRepository
 	logger     *zap.Logger
-	ctx        context.Context
+	config     Config
+	limiter    *hostLimiter
 }
 
 func NewTelegramParser(
 	repository repository.JobsRepository,
 	logger *zap.Logger,
-	context context.Context,
+	config Config,
 ) *telegramParser {
 	return &telegramParser{
 		repository: repository,
 		logger:     logger,
-		ctx:        context,
+		config:     config,
+		limiter:    newHostLimiter(config.RequestsPerSecond),
 	}
 }
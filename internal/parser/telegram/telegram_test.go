@@ -11,13 +11,12 @@ import (
 
 // TestTelegramParser проверяет корректность инициализации парсера
 func TestTelegramParser(t *testing.T) {
-	// GIVEN: Создаем тестовый логгер и контекст
+	// GIVEN: Создаем тестовый логгер
 	logger := zaptest.NewLogger(t)
-	ctx := context.Background()
 	mockRepo := test.NewMockRepository(logger)
 
 	// WHEN: Создаем новый парсер
-	parser := NewTelegramParser(mockRepo, logger, ctx)
+	parser := NewTelegramParser(mockRepo, logger, DefaultConfig())
 
 	// THEN: Проверяем, что парсер корректно инициализирован
 	assert.NotNil(t, parser)
@@ -32,10 +31,10 @@ func TestEmptyChannelsList(t *testing.T) {
 	mockRepo := test.NewMockRepository(logger)
 
 	// Создаем парсер
-	parser := NewTelegramParser(mockRepo, logger, ctx)
+	parser := NewTelegramParser(mockRepo, logger, DefaultConfig())
 
 	// WHEN: Вызываем метод парсинга
-	jobs, err := parser.ParseJobs()
+	jobs, err := parser.ParseJobs(ctx)
 
 	// THEN: Проверяем, что получен пустой список без ошибок
 	assert.NoError(t, err)
@@ -51,10 +50,10 @@ func TestRepositoryError(t *testing.T) {
 	mockRepo.ShouldError = true
 
 	// Создаем парсер
-	parser := NewTelegramParser(mockRepo, logger, ctx)
+	parser := NewTelegramParser(mockRepo, logger, DefaultConfig())
 
 	// WHEN: Вызываем метод парсинга
-	jobs, err := parser.ParseJobs()
+	jobs, err := parser.ParseJobs(ctx)
 
 	// THEN: Проверяем, что возникла ошибка
 	assert.Error(t, err)
@@ -0,0 +1,115 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap"
+)
+
+// backfillBatchSize - количество вакансий, обрабатываемых в одной транзакции
+const backfillBatchSize = 500
+
+// BackfillJobDetails заново извлекает зарплату, местоположение, грейд, тип занятости, контакты, хэштеги
+// и отпечаток текста для всех сохраненных вакансий по текущим настройкам. Вакансии обрабатываются
+// пачками по backfillBatchSize, каждая пачка - в своей транзакции. Возвращает количество обработанных вакансий
+func (r *repository) BackfillJobDetails(ctx context.Context) (int, error) {
+	op := "repository.jobs.BackfillJobDetails"
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	total := 0
+	var lastID int64
+
+	for {
+		query, args, err := psql.
+			Select("id", "content", "COALESCE(content_pure, '')", "source_link").
+			From("jobs_raw").
+			Where(squirrel.Gt{"id": lastID}).
+			OrderBy("id").
+			Limit(backfillBatchSize).
+			ToSql()
+
+		if err != nil {
+			return total, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+		}
+
+		rows, err := r.db.Query(ctx, query, args...)
+		if err != nil {
+			return total, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+		}
+
+		var batch []model.JobRaw
+		for rows.Next() {
+			var job model.JobRaw
+			if err := rows.Scan(&job.ID, &job.Content, &job.ContentPure, &job.SourceLink); err != nil {
+				rows.Close()
+				return total, fmt.Errorf("%s: сканирование строки: %w", op, err)
+			}
+
+			r.extractDetails(&job)
+			batch = append(batch, job)
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return total, fmt.Errorf("%s: итерация по результатам: %w", op, err)
+		}
+
+		if len(batch) == 0 {
+			break
+		}
+
+		if err := r.updateJobDetails(ctx, psql, batch); err != nil {
+			return total, fmt.Errorf("%s: %w", op, err)
+		}
+
+		total += len(batch)
+		lastID = batch[len(batch)-1].ID
+
+		r.logger.Debug("Пачка вакансий обработана", zap.Int("processed", total), zap.Int64("lastID", lastID))
+	}
+
+	r.logger.Info("Данные вакансий извлечены заново", zap.Int("processed", total))
+
+	return total, nil
+}
+
+// updateJobDetails сохраняет извлеченные данные пачки вакансий в одной транзакции
+func (r *repository) updateJobDetails(ctx context.Context, psql squirrel.StatementBuilderType, batch []model.JobRaw) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("начало транзакции: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, job := range batch {
+		update := psql.Update("jobs_raw").Where(squirrel.Eq{"id": job.ID})
+
+		values := detailsValues(job)
+		for i, column := range detailsColumns {
+			update = update.Set(column, values[i])
+		}
+
+		query, args, err := update.ToSql()
+		if err != nil {
+			return fmt.Errorf("формирование запроса обновления: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("обновление вакансии %d: %w", job.ID, err)
+		}
+
+		if err := replaceJobTags(ctx, tx, job.ID, job.Tags); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("завершение транзакции: %w", err)
+	}
+
+	return nil
+}
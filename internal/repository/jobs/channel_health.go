@@ -0,0 +1,205 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap"
+)
+
+// GetChannelHealth возвращает показатели здоровья всех Telegram-каналов, включая отключенные,
+// вместе с проблемами, найденными по порогам ChannelHealth
+func (r *repository) GetChannelHealth(ctx context.Context) ([]model.ChannelHealth, error) {
+	op := "repository.jobs.GetChannelHealth"
+
+	// Ошибки подряд - обходы с ошибкой после последнего успешного обхода и после ручного включения канала.
+	// Прерванные обходы (ограничение частоты запросов, отмена запуска) сбоями канала не считаются
+	query := `
+		SELECT
+			c.tag,
+			c.enabled,
+			COALESCE(c.disabled_reason, ''),
+			(
+				SELECT COUNT(*)
+				FROM scrape_run_channels rc
+				WHERE rc.channel = c.tag
+					AND rc.error IS NOT NULL
+					AND NOT rc.interrupted
+					AND rc.started_at > COALESCE(c.date_enabled, '-infinity')
+					AND rc.started_at > COALESCE((
+						SELECT MAX(ok.started_at)
+						FROM scrape_run_channels ok
+						WHERE ok.channel = c.tag AND ok.error IS NULL
+					), '-infinity')
+			),
+			j.last_posted,
+			GREATEST(j.last_posted, c.date_channel_added, c.date_enabled),
+			COALESCE(j.recent, 0),
+			COALESCE(j.stop_words, 0),
+			COALESCE(j.unclassified, 0)
+		FROM telegram_channels c
+		LEFT JOIN LATERAL (
+			SELECT
+				MAX(date_posted) AS last_posted,
+				COUNT(*) FILTER (WHERE date_posted > $2) AS recent,
+				COUNT(*) FILTER (WHERE date_posted > $2 AND cardinality(stop_words) > 0) AS stop_words,
+				COUNT(*) FILTER (
+					WHERE date_posted > $2
+						AND COALESCE(cardinality(stop_words), 0) = 0
+						AND COALESCE(main_technology, '') = ''
+				) AS unclassified
+			FROM jobs_raw
+			WHERE source = $1 AND channel = c.tag
+		) j ON TRUE
+		ORDER BY c.tag
+	`
+
+	now := time.Now()
+	since := now.Add(-r.config.ChannelHealth.Window)
+
+	rows, err := r.db.Query(ctx, query, sourceTelegram, since)
+	if err != nil {
+		return nil, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+	defer rows.Close()
+
+	var channels []model.ChannelHealth
+	for rows.Next() {
+		var h model.ChannelHealth
+		var stopWords, unclassified int
+
+		err := rows.Scan(&h.Tag, &h.Enabled, &h.DisabledReason, &h.ConsecutiveFailures,
+			&h.LastPosted, &h.LastActive, &h.RecentPosts, &stopWords, &unclassified)
+		if err != nil {
+			return nil, fmt.Errorf("%s: сканирование строки: %w", op, err)
+		}
+
+		if h.RecentPosts > 0 {
+			h.StopWordsShare = float64(stopWords) / float64(h.RecentPosts)
+			h.UnclassifiedShare = float64(unclassified) / float64(h.RecentPosts)
+		}
+		h.Issues = r.config.ChannelHealth.issues(h, now)
+
+		channels = append(channels, h)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: итерация по результатам: %w", op, err)
+	}
+
+	return channels, nil
+}
+
+// CheckChannelHealth проверяет здоровье включенных каналов: сохраняет найденные проблемы
+// и отключает каналы с проблемами из ChannelHealth.Disable. Возвращает количество отключенных каналов
+func (r *repository) CheckChannelHealth(ctx context.Context) (int, error) {
+	op := "repository.jobs.CheckChannelHealth"
+
+	channels, err := r.GetChannelHealth(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var tags, issues, reasons []string
+	for _, h := range channels {
+		if !h.Enabled {
+			continue
+		}
+
+		reason := ""
+		if disabling := r.config.ChannelHealth.disabling(h.Issues); len(disabling) > 0 {
+			reason = strings.Join(disabling, ", ")
+
+			r.logger.Warn("Канал отключен по результатам проверки здоровья",
+				zap.String("channel", h.Tag),
+				zap.Strings("issues", disabling),
+				zap.Int("consecutive failures", h.ConsecutiveFailures),
+				zap.Int("days since last post", h.DaysSinceLastPost(time.Now())),
+				zap.Float64("stop words share", h.StopWordsShare))
+		}
+
+		tags = append(tags, h.Tag)
+		// Массив передается строкой-литералом PostgreSQL, так как unnest не разворачивает вложенные массивы
+		issues = append(issues, "{"+strings.Join(h.Issues, ",")+"}")
+		reasons = append(reasons, reason)
+	}
+
+	if len(tags) == 0 {
+		return 0, nil
+	}
+
+	query := `
+		UPDATE telegram_channels c
+		SET health_issues = u.issues::text[],
+			enabled = u.reason = '',
+			disabled_reason = NULLIF(u.reason, ''),
+			date_disabled = CASE WHEN u.reason = '' THEN NULL ELSE NOW() END
+		FROM unnest($1::text[], $2::text[], $3::text[]) AS u(tag, issues, reason)
+		WHERE c.tag = u.tag AND c.enabled
+		RETURNING NOT c.enabled
+	`
+
+	rows, err := r.db.Query(ctx, query, pq.Array(tags), pq.Array(issues), pq.Array(reasons))
+	if err != nil {
+		return 0, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+	defer rows.Close()
+
+	disabled := 0
+	for rows.Next() {
+		var isDisabled bool
+		if err := rows.Scan(&isDisabled); err != nil {
+			return 0, fmt.Errorf("%s: сканирование строки: %w", op, err)
+		}
+		if isDisabled {
+			disabled++
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("%s: итерация по результатам: %w", op, err)
+	}
+
+	return disabled, nil
+}
+
+// issues возвращает проблемы канала с показателями h по порогам конфигурации
+func (c ChannelHealthConfig) issues(h model.ChannelHealth, now time.Time) []string {
+	var issues []string
+
+	if c.MaxConsecutiveFailures > 0 && h.ConsecutiveFailures >= c.MaxConsecutiveFailures {
+		issues = append(issues, model.ChannelIssueFailing)
+	}
+
+	if c.InactiveAfter > 0 && !h.LastActive.IsZero() && now.Sub(h.LastActive) > c.InactiveAfter {
+		issues = append(issues, model.ChannelIssueInactive)
+	}
+
+	// Доли оцениваются только при достаточном количестве вакансий
+	if h.RecentPosts >= max(c.MinPosts, 1) {
+		if c.MaxStopWordsShare > 0 && h.StopWordsShare >= c.MaxStopWordsShare {
+			issues = append(issues, model.ChannelIssueStopWords)
+		}
+		if c.MaxUnclassifiedShare > 0 && h.UnclassifiedShare >= c.MaxUnclassifiedShare {
+			issues = append(issues, model.ChannelIssueUnclassified)
+		}
+	}
+
+	return issues
+}
+
+// disabling возвращает проблемы из issues, при которых канал отключается
+func (c ChannelHealthConfig) disabling(issues []string) []string {
+	var disabling []string
+	for _, issue := range issues {
+		if slices.Contains(c.Disable, issue) {
+			disabling = append(disabling, issue)
+		}
+	}
+	return disabling
+}
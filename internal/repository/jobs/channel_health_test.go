@@ -0,0 +1,65 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+func TestChannelHealthIssues(t *testing.T) {
+	// GIVEN: Пороги проверки здоровья по умолчанию
+	config := DefaultConfig().ChannelHealth
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		health model.ChannelHealth
+		issues []string
+	}{
+		{
+			name:   "Здоровый канал",
+			health: model.ChannelHealth{LastActive: now.Add(-24 * time.Hour), RecentPosts: 20, StopWordsShare: 0.1},
+			issues: nil,
+		},
+		{
+			name:   "Ошибки обхода подряд",
+			health: model.ChannelHealth{LastActive: now, ConsecutiveFailures: 5},
+			issues: []string{model.ChannelIssueFailing},
+		},
+		{
+			name:   "Давно нет вакансий",
+			health: model.ChannelHealth{LastActive: now.Add(-100 * 24 * time.Hour)},
+			issues: []string{model.ChannelIssueInactive},
+		},
+		{
+			name:   "Рекламный канал без технологий",
+			health: model.ChannelHealth{LastActive: now, RecentPosts: 10, StopWordsShare: 0.6, UnclassifiedShare: 0.9},
+			issues: []string{model.ChannelIssueStopWords, model.ChannelIssueUnclassified},
+		},
+		{
+			name:   "Доли не оцениваются при малом количестве вакансий",
+			health: model.ChannelHealth{LastActive: now, RecentPosts: 3, StopWordsShare: 1},
+			issues: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// WHEN: Определяем проблемы канала
+			issues := config.issues(tc.health, now)
+
+			// THEN: Найдены ожидаемые проблемы
+			assert.Equal(t, tc.issues, issues)
+		})
+	}
+
+	t.Run("канал не по теме только отмечается", func(t *testing.T) {
+		// WHEN: Отбираем проблемы, при которых канал отключается
+		disabling := config.disabling([]string{model.ChannelIssueStopWords, model.ChannelIssueUnclassified})
+
+		// THEN: Отключение только из-за рекламы
+		assert.Equal(t, []string{model.ChannelIssueStopWords}, disabling)
+	})
+}
@@ -0,0 +1,149 @@
+package jobs
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/extract"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap"
+)
+
+// titleHitWeight - сколько очков дает совпадение ключевого слова в заголовке вакансии.
+// Совпадение в остальном тексте дает одно очко
+const titleHitWeight = 3
+
+// tagHitWeight - сколько очков дает хэштег поста, связанный с технологией через tag_aliases
+const tagHitWeight = 3
+
+// technologyMatcher - технология со скомпилированными ключевыми словами
+type technologyMatcher struct {
+	id         int64
+	technology string
+	keywords   []extract.Keyword
+	negative   []extract.Keyword
+	// tags - хэштеги технологии в нижнем регистре
+	tags []string
+}
+
+// classifier определяет технологии и стоп-слова вакансий.
+// Ключевые слова компилируются один раз при создании и переиспользуются для всех вакансий запуска
+type classifier struct {
+	technologies []technologyMatcher
+	stopWords    []model.StopWord
+	// stopWordsLower - стоп-слова в нижнем регистре, в том же порядке, что и stopWords
+	stopWordsLower []string
+}
+
+// newClassifier компилирует ключевые слова технологий. Некорректные ключевые слова
+// пропускаются с предупреждением, чтобы одна ошибка в CSV не отключала всю классификацию
+func newClassifier(technologies []model.Technology, stopWords []model.StopWord, logger *zap.Logger) *classifier {
+	c := &classifier{
+		technologies:   make([]technologyMatcher, 0, len(technologies)),
+		stopWords:      stopWords,
+		stopWordsLower: make([]string, len(stopWords)),
+	}
+
+	for i, stopWord := range stopWords {
+		c.stopWordsLower[i] = strings.ToLower(stopWord.Word)
+	}
+
+	for _, tech := range technologies {
+		matcher := technologyMatcher{id: tech.ID, technology: tech.Technology}
+		for _, tag := range tech.Tags {
+			matcher.tags = append(matcher.tags, strings.ToLower(strings.TrimPrefix(tag, "#")))
+		}
+
+		for _, raw := range tech.Keywords {
+			keyword, err := extract.CompileKeyword(raw)
+			if err != nil {
+				logger.Warn("Некорректное ключевое слово технологии пропущено",
+					zap.String("technology", tech.Technology),
+					zap.Error(err))
+				continue
+			}
+
+			if keyword.Negative() {
+				matcher.negative = append(matcher.negative, keyword)
+			} else {
+				matcher.keywords = append(matcher.keywords, keyword)
+			}
+		}
+
+		c.technologies = append(c.technologies, matcher)
+	}
+
+	return c
+}
+
+// classify определяет стоп-слова и технологии вакансии.
+// Если в тексте встречается хотя бы одно стоп-слово, технологии не определяются
+func (c *classifier) classify(job *model.JobRaw) {
+	job.StopWords = c.findStopWords(job.Content)
+	job.Technologies = nil
+	job.MainTechnology = ""
+
+	if len(job.StopWords) > 0 {
+		return
+	}
+
+	job.Technologies = c.detectTechnologies(job.Title, job.Content, job.Tags)
+	if len(job.Technologies) > 0 {
+		job.MainTechnology = job.Technologies[0].Technology
+	}
+}
+
+// detectTechnologies возвращает все технологии, ключевые слова которых встречаются в вакансии,
+// по убыванию релевантности. Релевантность - сумма совпадений всех ключевых слов технологии,
+// совпадения в заголовке весят titleHitWeight, хэштеги поста из tag_aliases технологии - tagHitWeight.
+// Технологии с найденным отрицательным ключевым словом пропускаются.
+// При равной релевантности сохраняется порядок технологий (sort_order)
+func (c *classifier) detectTechnologies(title, content string, tags []string) []model.JobTechnology {
+	var found []model.JobTechnology
+
+technologies:
+	for _, tech := range c.technologies {
+		for _, keyword := range tech.negative {
+			if keyword.Count(content) > 0 {
+				continue technologies
+			}
+		}
+
+		// Заголовок входит в текст вакансии, поэтому за совпадение в нем добавляем недостающие очки
+		score := 0
+		for _, keyword := range tech.keywords {
+			score += keyword.Count(content) + (titleHitWeight-1)*keyword.Count(title)
+		}
+		for _, tag := range tech.tags {
+			if slices.Contains(tags, tag) {
+				score += tagHitWeight
+			}
+		}
+
+		if score > 0 {
+			found = append(found, model.JobTechnology{
+				TechnologyID: tech.id,
+				Technology:   tech.technology,
+				Score:        score,
+			})
+		}
+	}
+
+	slices.SortStableFunc(found, func(a, b model.JobTechnology) int {
+		return b.Score - a.Score
+	})
+
+	return found
+}
+
+// findStopWords возвращает стоп-слова, которые встречаются в тексте вакансии
+func (c *classifier) findStopWords(content string) []string {
+	var foundStopWords []string
+	contentLower := strings.ToLower(content)
+	for i, stopWord := range c.stopWords {
+		if strings.Contains(contentLower, c.stopWordsLower[i]) {
+			foundStopWords = append(foundStopWords, stopWord.Word)
+		}
+	}
+	return foundStopWords
+}
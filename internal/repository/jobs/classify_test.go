@@ -0,0 +1,223 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestClassify(t *testing.T) {
+	technologies := []model.Technology{
+		{
+			ID:         1,
+			Technology: "Go",
+			Keywords:   []string{"golang", "go разработчик*"},
+		},
+		{
+			ID:         2,
+			Technology: "Python",
+			Keywords:   []string{"python", "джуниор python"},
+		},
+	}
+
+	stopWords := []model.StopWord{
+		{
+			Word: "стремитесь",
+		},
+		{
+			Word: "адвокат",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		content     string
+		expected    string
+		description string
+	}{
+		{
+			name:        "Technology found",
+			content:     "Ищем опытного Go разработчика",
+			expected:    "Go",
+			description: "Должен определить правильную технологию",
+		},
+		{
+			name:        "No technology match",
+			content:     "Ищем руководителя проекта",
+			expected:    "",
+			description: "Должен вернуть пустую строку, если технология не найдена",
+		},
+		{
+			name:        "Stop word found",
+			content:     "Стремитесь к новым высотам с Go разработкой",
+			expected:    "",
+			description: "Должен вернуть пустую строку, если найдено стоп-слово",
+		},
+		{
+			name:        "Stop word with uppercase",
+			content:     "АДВОКАТ для Go разработчика",
+			expected:    "",
+			description: "Должен игнорировать регистр при поиске стоп-слов",
+		},
+		{
+			name:        "Multiple technologies match but stop word",
+			content:     "Нужен Go и Python разработчик, стремитесь получить работу",
+			expected:    "",
+			description: "Должен вернуть пустую строку, если есть стоп-слово, даже если найдены технологии",
+		},
+	}
+
+	classifier := newClassifier(technologies, stopWords, zaptest.NewLogger(t))
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			job := model.JobRaw{Content: tc.content}
+			classifier.classify(&job)
+			assert.Equal(t, tc.expected, job.MainTechnology, tc.description)
+			if tc.expected == "" {
+				assert.Empty(t, job.Technologies, tc.description)
+			}
+		})
+	}
+}
+
+func TestDetectTechnologies(t *testing.T) {
+	technologies := []model.Technology{
+		{ID: 1, Technology: "Go", Keywords: []string{"golang"}},
+		{ID: 2, Technology: "Kubernetes", Keywords: []string{"kubernetes", "k8s"}},
+		{ID: 3, Technology: "PostgreSQL", Keywords: []string{"postgresql"}},
+		{ID: 4, Technology: "Java", Keywords: []string{"java"}},
+	}
+	classifier := newClassifier(technologies, nil, zaptest.NewLogger(t))
+
+	t.Run("все совпадения по убыванию релевантности", func(t *testing.T) {
+		// GIVEN: Вакансия, где Kubernetes упоминается чаще остальных технологий
+		title := "DevOps инженер"
+		content := "DevOps инженер. Kubernetes, k8s операторы на Golang, PostgreSQL"
+
+		// WHEN: Определяем технологии
+		found := classifier.detectTechnologies(title, content, nil)
+
+		// THEN: Найдены все три технологии, первой идет самая релевантная
+		assert.Equal(t, []model.JobTechnology{
+			{TechnologyID: 2, Technology: "Kubernetes", Score: 2},
+			{TechnologyID: 1, Technology: "Go", Score: 1},
+			{TechnologyID: 3, Technology: "PostgreSQL", Score: 1},
+		}, found)
+	})
+
+	t.Run("совпадение в заголовке весит больше", func(t *testing.T) {
+		// GIVEN: Go в заголовке, Kubernetes дважды в тексте
+		title := "Golang разработчик"
+		content := "Golang разработчик. Будет плюсом Kubernetes и опыт с k8s"
+
+		// WHEN: Определяем технологии
+		found := classifier.detectTechnologies(title, content, nil)
+
+		// THEN: Основной считается технология из заголовка
+		assert.Len(t, found, 2)
+		assert.Equal(t, "Go", found[0].Technology)
+		assert.Equal(t, titleHitWeight, found[0].Score)
+	})
+
+	t.Run("при равной релевантности сохраняется приоритет", func(t *testing.T) {
+		// GIVEN: Две технологии с одинаковым количеством совпадений
+		content := "Нужен опыт с PostgreSQL и Golang"
+
+		// WHEN: Определяем технологии
+		found := classifier.detectTechnologies("", content, nil)
+
+		// THEN: Порядок соответствует порядку списка технологий
+		assert.Equal(t, "Go", found[0].Technology)
+		assert.Equal(t, "PostgreSQL", found[1].Technology)
+	})
+
+	t.Run("без совпадений", func(t *testing.T) {
+		// WHEN: Определяем технологии в тексте без ключевых слов
+		found := classifier.detectTechnologies("", "Ищем руководителя проекта", nil)
+
+		// THEN: Технологии не найдены
+		assert.Empty(t, found)
+	})
+}
+
+func TestDetectTechnologiesKeywordSyntax(t *testing.T) {
+	technologies := []model.Technology{
+		{ID: 1, Technology: "Go", Keywords: []string{"go", "golang", `!re:go\s+to\b`}},
+		{ID: 2, Technology: "Java", Keywords: []string{"java"}},
+		{ID: 3, Technology: "TypeScript", Keywords: []string{"ts"}},
+		{ID: 4, Technology: "Kubernetes", Keywords: []string{`re:\bk(8s|ubernetes)\b`}},
+		{ID: 5, Technology: "Analytics", Keywords: []string{"аналитик*"}},
+		{ID: 6, Technology: "C++", Keywords: []string{"c++"}},
+	}
+	classifier := newClassifier(technologies, nil, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{name: "Целое слово", content: "Ищем Go-разработчика", expected: []string{"Go"}},
+		{name: "Часть слова не совпадает", content: "Работа в Google, Javascript, requests", expected: nil},
+		{name: "Кириллица вокруг ключевого слова", content: "Стек:java,ts", expected: []string{"Java", "TypeScript"}},
+		{name: "Регулярное выражение", content: "Деплой в K8s", expected: []string{"Kubernetes"}},
+		{name: "Окончание слова по звездочке", content: "Ищем аналитика данных", expected: []string{"Analytics"}},
+		{name: "Ключевое слово из спецсимволов", content: "Разработчик C++/Qt", expected: []string{"C++"}},
+		{name: "Отрицательное ключевое слово", content: "Go to market менеджер, знание golang не нужно", expected: nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var names []string
+			for _, tech := range classifier.detectTechnologies("", tc.content, nil) {
+				names = append(names, tech.Technology)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestClassifierInvalidKeywords(t *testing.T) {
+	// GIVEN: Технология с одним корректным и одним некорректным ключевым словом
+	technologies := []model.Technology{
+		{ID: 1, Technology: "Go", Keywords: []string{"re:(go", "golang"}},
+	}
+
+	// WHEN: Создаем классификатор
+	classifier := newClassifier(technologies, nil, zaptest.NewLogger(t))
+
+	// THEN: Некорректное ключевое слово пропущено, корректное продолжает работать
+	assert.Len(t, classifier.detectTechnologies("", "Golang", nil), 1)
+}
+
+func TestDetectTechnologiesTags(t *testing.T) {
+	technologies := []model.Technology{
+		{ID: 1, Technology: "Go", Keywords: []string{"golang", "!1с"}, Tags: []string{"go", "#Golang"}},
+		{ID: 2, Technology: "Python", Keywords: []string{"python"}},
+	}
+	classifier := newClassifier(technologies, nil, zaptest.NewLogger(t))
+
+	t.Run("хэштег из tag_aliases определяет технологию", func(t *testing.T) {
+		// GIVEN: Пост без ключевых слов Go, но с хэштегом #go
+		content := "Backend разработчик, опыт с Python. #go #remote"
+
+		// WHEN: Определяем технологии с хэштегами поста
+		found := classifier.detectTechnologies("", content, []string{"go", "remote"})
+
+		// THEN: Хэштег весит больше одного упоминания в тексте
+		assert.Equal(t, []model.JobTechnology{
+			{TechnologyID: 1, Technology: "Go", Score: tagHitWeight},
+			{TechnologyID: 2, Technology: "Python", Score: 1},
+		}, found)
+	})
+
+	t.Run("отрицательное ключевое слово исключает технологию с хэштегом", func(t *testing.T) {
+		// WHEN: В тексте есть отрицательное ключевое слово технологии
+		found := classifier.detectTechnologies("", "Программист 1С #go", []string{"go"})
+
+		// THEN: Технология не найдена
+		assert.Empty(t, found)
+	})
+}
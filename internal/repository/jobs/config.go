@@ -0,0 +1,86 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/extract"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// Config задает параметры обработки вакансий при сохранении
+type Config struct {
+	// Salary - приведение зарплат к базовой валюте
+	Salary extract.SalaryConfig `yaml:"salary"`
+	// Location - фильтрация вакансий по формату работы
+	Location LocationConfig `yaml:"location"`
+	// Seniority - словарь грейдов; порядок записей задает порядок значений в вакансии
+	Seniority []extract.DictionaryEntry `yaml:"seniority"`
+	// EmploymentTypes - словарь типов занятости
+	EmploymentTypes []extract.DictionaryEntry `yaml:"employment_types"`
+	// Duplicates - поиск одной и той же вакансии, опубликованной в нескольких каналах
+	Duplicates DuplicatesConfig `yaml:"duplicates"`
+	// ClosingPhrases - ключевые слова, по которым вакансия считается закрытой
+	ClosingPhrases []string `yaml:"closing_phrases"`
+	// ExpireAfter - через сколько после публикации активная вакансия считается устаревшей; 0 отключает устаревание
+	ExpireAfter time.Duration `yaml:"expire_after"`
+	// ChannelHealth - проверка здоровья каналов и их автоматическое отключение
+	ChannelHealth ChannelHealthConfig `yaml:"channel_health"`
+}
+
+// LocationConfig задает фильтрацию вакансий по формату работы при сохранении
+type LocationConfig struct {
+	// DropWorkFormats - форматы работы (model.WorkFormat*), вакансии с которыми не сохраняются.
+	// Вакансии без указанного формата сохраняются всегда
+	DropWorkFormats []string `yaml:"drop_work_formats"`
+}
+
+// DuplicatesConfig задает поиск дубликатов вакансий по отпечатку текста (SimHash)
+type DuplicatesConfig struct {
+	// Window - за какой период сравнивать новую вакансию с сохраненными; 0 отключает поиск дубликатов
+	Window time.Duration `yaml:"window"`
+	// MaxDistance - максимальное количество различающихся бит отпечатков, при котором вакансии считаются одной
+	MaxDistance int `yaml:"max_distance"`
+}
+
+// ChannelHealthConfig задает пороги проверки здоровья каналов. Нулевой порог отключает соответствующую проверку
+type ChannelHealthConfig struct {
+	// Window - период, за который считаются доли вакансий со стоп-словами и без технологии
+	Window time.Duration `yaml:"window"`
+	// MinPosts - минимальное количество вакансий за Window, при котором оцениваются доли
+	MinPosts int `yaml:"min_posts"`
+	// MaxConsecutiveFailures - количество ошибок обхода подряд, после которого канал считается сломанным
+	MaxConsecutiveFailures int `yaml:"max_consecutive_failures"`
+	// InactiveAfter - сколько канал может не давать новых вакансий, прежде чем считаться неактивным
+	InactiveAfter time.Duration `yaml:"inactive_after"`
+	// MaxStopWordsShare - доля вакансий со стоп-словами (от 0 до 1), начиная с которой канал считается рекламным
+	MaxStopWordsShare float64 `yaml:"max_stop_words_share"`
+	// MaxUnclassifiedShare - доля вакансий без технологии (от 0 до 1), начиная с которой канал отмечается
+	MaxUnclassifiedShare float64 `yaml:"max_unclassified_share"`
+	// Disable - проблемы (model.ChannelIssue*), при которых канал отключается автоматически.
+	// Остальные найденные проблемы только отмечаются у канала
+	Disable []string `yaml:"disable"`
+}
+
+// DefaultConfig возвращает конфигурацию обработки вакансий по умолчанию
+func DefaultConfig() Config {
+	return Config{
+		Salary:          extract.DefaultSalaryConfig(),
+		Seniority:       extract.DefaultSeniority(),
+		EmploymentTypes: extract.DefaultEmploymentTypes(),
+		ClosingPhrases:  extract.DefaultClosingPhrases(),
+		ExpireAfter:     30 * 24 * time.Hour,
+		Duplicates: DuplicatesConfig{
+			Window:      30 * 24 * time.Hour,
+			MaxDistance: 4,
+		},
+		ChannelHealth: ChannelHealthConfig{
+			Window:                 30 * 24 * time.Hour,
+			MinPosts:               10,
+			MaxConsecutiveFailures: 5,
+			InactiveAfter:          90 * 24 * time.Hour,
+			MaxStopWordsShare:      0.5,
+			MaxUnclassifiedShare:   0.8,
+			Disable:                []string{model.ChannelIssueFailing, model.ChannelIssueInactive, model.ChannelIssueStopWords},
+		},
+	}
+}
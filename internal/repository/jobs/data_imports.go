@@ -0,0 +1,29 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+)
+
+// IsDataImported сообщает, загружались ли уже файлы data/ в БД
+func (r *repository) IsDataImported(ctx context.Context) (bool, error) {
+	op := "repository.jobs.IsDataImported"
+
+	var imported bool
+	if err := r.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM data_imports)`).Scan(&imported); err != nil {
+		return false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return imported, nil
+}
+
+// SaveDataImport отмечает, что файлы data/ загружены в БД
+func (r *repository) SaveDataImport(ctx context.Context) error {
+	op := "repository.jobs.SaveDataImport"
+
+	if _, err := r.db.Exec(ctx, `INSERT INTO data_imports DEFAULT VALUES`); err != nil {
+		return fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/extract"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// fingerprint - отпечаток сохраненной вакансии
+type fingerprint struct {
+	jobID   int64
+	simHash uint64
+}
+
+// duplicateIndex ищет среди недавних вакансий ту, почти дубликатом которой является новая.
+// Сравнение полным перебором: за окно поиска вакансий немного, а для расстояния Хэмминга
+// индекс в PostgreSQL без расширений не построить
+type duplicateIndex struct {
+	maxDistance  int
+	fingerprints []fingerprint
+}
+
+// loadDuplicateIndex загружает отпечатки вакансий, опубликованных за config.Duplicates.Window.
+// При нулевом окне возвращает nil: поиск дубликатов отключен
+func (r *repository) loadDuplicateIndex(ctx context.Context) (*duplicateIndex, error) {
+	config := r.config.Duplicates
+	if config.Window <= 0 {
+		return nil, nil
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Select("id", "simhash").
+		From("jobs_raw").
+		Where(squirrel.NotEq{"simhash": nil}).
+		Where(squirrel.Gt{"date_posted": time.Now().Add(-config.Window)}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("формирование запроса отпечатков вакансий: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("выполнение запроса отпечатков вакансий: %w", err)
+	}
+	defer rows.Close()
+
+	index := &duplicateIndex{maxDistance: config.MaxDistance}
+	for rows.Next() {
+		var jobID, simHash int64
+		if err := rows.Scan(&jobID, &simHash); err != nil {
+			return nil, fmt.Errorf("сканирование отпечатков вакансий: %w", err)
+		}
+		index.add(jobID, uint64(simHash))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("итерация по отпечаткам вакансий: %w", err)
+	}
+
+	return index, nil
+}
+
+// find возвращает ID ближайшей вакансии, отпечаток которой отличается не больше чем на maxDistance бит.
+// Вакансии без отпечатка (слишком короткие) дубликатами не считаются
+func (d *duplicateIndex) find(simHash uint64) (int64, bool) {
+	if d == nil || simHash == 0 {
+		return 0, false
+	}
+
+	var jobID int64
+	best := d.maxDistance + 1
+	for _, fp := range d.fingerprints {
+		if distance := extract.HammingDistance(fp.simHash, simHash); distance < best {
+			jobID, best = fp.jobID, distance
+		}
+	}
+
+	return jobID, best <= d.maxDistance
+}
+
+// add добавляет отпечаток сохраненной вакансии, чтобы находить ее копии из того же пакета
+func (d *duplicateIndex) add(jobID int64, simHash uint64) {
+	if d == nil || simHash == 0 {
+		return
+	}
+	d.fingerprints = append(d.fingerprints, fingerprint{jobID: jobID, simHash: simHash})
+}
+
+// insertJobSource записывает пост, в котором опубликована вакансия, в job_sources
+func insertJobSource(ctx context.Context, tx pgx.Tx, jobID int64, job model.JobRaw) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Insert("job_sources").
+		Columns("job_id", "source_link", "date_posted").
+		Values(jobID, job.SourceLink, job.DatePosted).
+		Suffix("ON CONFLICT (source_link) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("формирование запроса источника вакансии: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("сохранение источника вакансии %d: %w", jobID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuplicateIndex(t *testing.T) {
+	// GIVEN: Индекс с двумя сохраненными вакансиями
+	index := &duplicateIndex{maxDistance: 3}
+	index.add(1, 0b1111_0000)
+	index.add(2, 0b1111_1111)
+
+	t.Run("находит ближайшую вакансию в пределах порога", func(t *testing.T) {
+		// WHEN: Ищем отпечаток, отличающийся от второй вакансии на один бит
+		jobID, ok := index.find(0b0111_1111)
+
+		// THEN: Найдена вторая вакансия
+		assert.True(t, ok)
+		assert.Equal(t, int64(2), jobID)
+	})
+
+	t.Run("отпечаток дальше порога не считается дубликатом", func(t *testing.T) {
+		_, ok := index.find(0b1111_0000_0000_1111)
+		assert.False(t, ok)
+	})
+
+	t.Run("вакансия без отпечатка не считается дубликатом", func(t *testing.T) {
+		index.add(3, 0)
+		_, ok := index.find(0)
+		assert.False(t, ok)
+	})
+
+	t.Run("отключенный поиск дубликатов", func(t *testing.T) {
+		// GIVEN: Индекс не загружен, потому что окно поиска равно 0
+		var disabled *duplicateIndex
+
+		// WHEN: Добавляем и ищем отпечаток
+		disabled.add(1, 0b1)
+		_, ok := disabled.find(0b1)
+
+		// THEN: Дубликаты не ищутся
+		assert.False(t, ok)
+	})
+}
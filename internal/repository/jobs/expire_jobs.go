@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap"
+)
+
+// ExpireJobs переводит в статус expired активные вакансии, опубликованные раньше срока ExpireAfter.
+// Возвращает количество устаревших вакансий
+func (r *repository) ExpireJobs(ctx context.Context) (int, error) {
+	op := "repository.jobs.ExpireJobs"
+
+	if r.config.ExpireAfter <= 0 {
+		return 0, nil
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Update("jobs_raw").
+		Set("status", model.JobStatusExpired).
+		Set("date_closed", squirrel.Expr("COALESCE(date_closed, NOW())")).
+		Where(squirrel.Eq{"status": model.JobStatusActive}).
+		Where(squirrel.Lt{"date_posted": time.Now().Add(-r.config.ExpireAfter)}).
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	expired := int(result.RowsAffected())
+	if expired > 0 {
+		r.logger.Info("Устаревшие вакансии переведены в статус expired", zap.Int("count", expired))
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,155 @@
+package jobs
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/extract"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// salaryColumns - колонки jobs_raw с зарплатой в порядке значений salaryValues
+var salaryColumns = []string{"salary_min", "salary_max", "salary_currency", "salary_period", "salary_tax", "salary_min_base", "salary_max_base"}
+
+// locationColumns - колонки jobs_raw с местоположением в порядке значений locationValues
+var locationColumns = []string{"work_format", "relocation", "countries", "cities", "timezone_min", "timezone_max"}
+
+// levelColumns - колонки jobs_raw с грейдом и типом занятости в порядке значений levelValues
+var levelColumns = []string{"seniority", "employment_type"}
+
+// contactsColumns - колонки jobs_raw с компанией и контактами в порядке значений contactsValues
+var contactsColumns = []string{"company", "telegram_contacts", "emails", "phones", "apply_urls"}
+
+// detailsColumns - все колонки jobs_raw с извлеченными из текста данными в порядке значений detailsValues
+var detailsColumns = slices.Concat(salaryColumns, locationColumns, levelColumns, contactsColumns, []string{"simhash"})
+
+// extractDetails извлекает из текста вакансии зарплату, местоположение, грейд, тип занятости, контакты,
+// хэштеги и отпечаток текста для поиска дубликатов, а также определяет, помечена ли вакансия закрытой
+func (r *repository) extractDetails(job *model.JobRaw) {
+	job.Salary = nil
+	if salary, ok := extract.Salary(job.ContentPure); ok {
+		r.config.Salary.Normalize(&salary)
+		job.Salary = &salary
+	}
+
+	job.Location = extract.Location(job.ContentPure)
+	job.Seniority = r.seniority.Match(job.ContentPure)
+	job.EmploymentType = r.employmentTypes.Match(job.ContentPure)
+
+	// Ссылку на канал, опубликовавший вакансию, контактом не считаем
+	job.Contacts = extract.Contacts(job.Content, job.ContentPure)
+	if tag := channelTagFromLink(job.SourceLink); tag != "" {
+		job.Contacts.Telegram = slices.DeleteFunc(job.Contacts.Telegram, func(name string) bool {
+			return strings.EqualFold(name, tag)
+		})
+	}
+
+	job.Tags = extract.Hashtags(job.Content)
+
+	job.SimHash, _ = extract.SimHash(job.ContentPure)
+
+	job.Status = model.JobStatusActive
+	if len(r.closing.Match(job.ContentPure)) > 0 {
+		job.Status = model.JobStatusClosed
+	} else if r.isExpired(job.DatePosted) {
+		job.Status = model.JobStatusExpired
+	}
+}
+
+// isExpired сообщает, что вакансия, опубликованная datePosted, устарела согласно ExpireAfter
+func (r *repository) isExpired(datePosted time.Time) bool {
+	return r.config.ExpireAfter > 0 && !datePosted.IsZero() && datePosted.Before(time.Now().Add(-r.config.ExpireAfter))
+}
+
+// channelTagFromLink возвращает тег канала из ссылки на пост вида https://t.me/<tag>/<id>
+func channelTagFromLink(link string) string {
+	parts := strings.Split(link, "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
+
+// isDropped сообщает, что вакансию не нужно сохранять из-за формата работы
+func (r *repository) isDropped(job model.JobRaw) bool {
+	return job.Location.WorkFormat != "" && slices.Contains(r.config.Location.DropWorkFormats, job.Location.WorkFormat)
+}
+
+// skipDropped сообщает, что исключенный по формату работы пост не нужно сохранять. Пост, уже
+// сохраненный как вакансия (saved), не пропускается: иначе повторный сбор оставил бы его активным
+// со старым текстом, поэтому его статус меняется на закрытый и вакансия обновляется
+func (r *repository) skipDropped(job *model.JobRaw, saved bool) bool {
+	if !r.isDropped(*job) {
+		return false
+	}
+
+	if saved {
+		job.Status = model.JobStatusClosed
+		return false
+	}
+
+	return true
+}
+
+// detailsValues возвращает значения колонок detailsColumns
+func detailsValues(job model.JobRaw) []any {
+	return slices.Concat(
+		salaryValues(job.Salary),
+		locationValues(job.Location),
+		[]any{pq.Array(job.Seniority), pq.Array(job.EmploymentType)},
+		contactsValues(job.Contacts),
+		// BIGINT в PostgreSQL знаковый, поэтому отпечаток хранится с тем же набором бит как int64
+		[]any{nullIfZero(int64(job.SimHash))},
+	)
+}
+
+// salaryValues возвращает значения колонок salaryColumns; ненайденные значения сохраняются как NULL
+func salaryValues(salary *model.Salary) []any {
+	if salary == nil {
+		return make([]any, len(salaryColumns))
+	}
+
+	return []any{
+		nullIfZero(salary.Min),
+		nullIfZero(salary.Max),
+		nullIfZero(salary.Currency),
+		nullIfZero(salary.Period),
+		nullIfZero(salary.Tax),
+		nullIfZero(salary.MinBase),
+		nullIfZero(salary.MaxBase),
+	}
+}
+
+// locationValues возвращает значения колонок locationColumns
+func locationValues(location model.Location) []any {
+	return []any{
+		nullIfZero(location.WorkFormat),
+		location.Relocation,
+		pq.Array(location.Countries),
+		pq.Array(location.Cities),
+		location.TimezoneMin,
+		location.TimezoneMax,
+	}
+}
+
+// contactsValues возвращает значения колонок contactsColumns
+func contactsValues(contacts model.Contacts) []any {
+	return []any{
+		nullIfZero(contacts.Company),
+		pq.Array(contacts.Telegram),
+		pq.Array(contacts.Emails),
+		pq.Array(contacts.Phones),
+		pq.Array(contacts.ApplyURLs),
+	}
+}
+
+// nullIfZero возвращает nil для нулевого значения, чтобы оно сохранилось в БД как NULL
+func nullIfZero[T comparable](value T) any {
+	var zero T
+	if value == zero {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,113 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestExtractDetails(t *testing.T) {
+	// GIVEN: Репозиторий, исключающий офисные вакансии
+	config := DefaultConfig()
+	config.Location.DropWorkFormats = []string{model.WorkFormatOffice}
+	repo := NewRepository(nil, zaptest.NewLogger(t), config)
+
+	tests := []struct {
+		name    string
+		content string
+		dropped bool
+	}{
+		{name: "Удаленная вакансия сохраняется", content: "Удаленно, зарплата от 3000 $", dropped: false},
+		{name: "Офисная вакансия исключается", content: "Работа в офисе в Москве", dropped: true},
+		{name: "Формат не указан", content: "Ищем Go разработчика", dropped: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// WHEN: Извлекаем данные вакансии
+			job := model.JobRaw{ContentPure: tc.content}
+			repo.extractDetails(&job)
+
+			// THEN: Решение об исключении соответствует формату работы
+			assert.Equal(t, tc.dropped, repo.isDropped(job))
+		})
+	}
+
+	t.Run("сохраненная офисная вакансия закрывается, а не пропускается", func(t *testing.T) {
+		// GIVEN: Пост, который после правки стал офисным
+		job := model.JobRaw{ContentPure: "Работа в офисе в Москве"}
+		repo.extractDetails(&job)
+		require.Equal(t, model.JobStatusActive, job.Status)
+
+		// WHEN/THEN: Несохраненный пост пропускается без изменений
+		unsaved := job
+		assert.True(t, repo.skipDropped(&unsaved, false))
+		assert.Equal(t, model.JobStatusActive, unsaved.Status)
+
+		// WHEN/THEN: Сохраненная вакансия не пропускается и закрывается
+		assert.False(t, repo.skipDropped(&job, true))
+		assert.Equal(t, model.JobStatusClosed, job.Status)
+	})
+
+	t.Run("зарплата приводится к базовой валюте", func(t *testing.T) {
+		// WHEN: Извлекаем данные вакансии с зарплатой в долларах
+		job := model.JobRaw{ContentPure: "Удаленно, зарплата от 3000 $"}
+		repo.extractDetails(&job)
+
+		// THEN: Зарплата найдена и пересчитана по курсу
+		if assert.NotNil(t, job.Salary) {
+			assert.Equal(t, int64(3000*90), job.Salary.MinBase)
+		}
+		assert.Equal(t, model.WorkFormatRemote, job.Location.WorkFormat)
+	})
+
+	t.Run("грейд и тип занятости", func(t *testing.T) {
+		// WHEN: Извлекаем данные вакансии с грейдом и типом занятости
+		job := model.JobRaw{ContentPure: "Senior Go developer, full-time, B2B contract"}
+		repo.extractDetails(&job)
+
+		// THEN: Найдены значения из словарей по умолчанию
+		assert.Equal(t, []string{"senior"}, job.Seniority)
+		assert.Equal(t, []string{"full_time", "contract"}, job.EmploymentType)
+	})
+
+	t.Run("канал вакансии не считается контактом", func(t *testing.T) {
+		// GIVEN: Пост канала golang_jobs с упоминанием самого канала и рекрутера
+		job := model.JobRaw{
+			Content:     `Пишите <a href="https://t.me/hr_anna">@hr_anna</a>, подписывайтесь на @golang_jobs`,
+			ContentPure: "Пишите @hr_anna, подписывайтесь на @golang_jobs",
+			SourceLink:  "https://t.me/golang_jobs/123",
+		}
+
+		// WHEN: Извлекаем данные вакансии
+		repo.extractDetails(&job)
+
+		// THEN: В контактах остался только рекрутер
+		assert.Equal(t, []string{"hr_anna"}, job.Contacts.Telegram)
+	})
+
+	t.Run("статус закрытой вакансии", func(t *testing.T) {
+		// WHEN: Извлекаем данные обычной и закрытой вакансии
+		active := model.JobRaw{ContentPure: "Ищем Go разработчика"}
+		repo.extractDetails(&active)
+		closed := model.JobRaw{ContentPure: "UPD: вакансия закрыта. Ищем Go разработчика"}
+		repo.extractDetails(&closed)
+
+		// THEN: Статус определен по фразам о закрытии
+		assert.Equal(t, model.JobStatusActive, active.Status)
+		assert.Equal(t, model.JobStatusClosed, closed.Status)
+	})
+
+	t.Run("статус устаревшей вакансии", func(t *testing.T) {
+		// WHEN: Извлекаем данные вакансии, опубликованной раньше срока ExpireAfter
+		job := model.JobRaw{ContentPure: "Ищем Go разработчика", DatePosted: time.Now().Add(-config.ExpireAfter - time.Hour)}
+		repo.extractDetails(&job)
+
+		// THEN: Вакансия сразу сохраняется устаревшей
+		assert.Equal(t, model.JobStatusExpired, job.Status)
+	})
+}
@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// GetJobBySlug возвращает вакансию по slug в любом статусе вместе с технологиями и хэштегами.
+// Возвращает false, если вакансии с таким slug нет
+func (r *repository) GetJobBySlug(ctx context.Context, slug string) (model.JobRaw, bool, error) {
+	op := "repository.jobs.GetJobBySlug"
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Select(jobColumns...).
+		From("jobs_raw").
+		Where(squirrel.Eq{"slug": slug}).
+		ToSql()
+
+	if err != nil {
+		return model.JobRaw{}, false, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	job, err := scanJob(r.db.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.JobRaw{}, false, nil
+	}
+	if err != nil {
+		return model.JobRaw{}, false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	jobs := []model.JobRaw{job}
+	if err := r.loadJobRelations(ctx, jobs); err != nil {
+		return model.JobRaw{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return jobs[0], true, nil
+}
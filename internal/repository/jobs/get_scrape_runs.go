@@ -0,0 +1,49 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// GetScrapeRuns возвращает последние запуски парсеров, начиная с самого нового, без результатов по каналам.
+// limit <= 0 означает без ограничения
+func (r *repository) GetScrapeRuns(ctx context.Context, limit int) ([]model.RunReport, error) {
+	op := "repository.jobs.GetScrapeRuns"
+
+	query := `
+		SELECT id, parser, started_at, finished_at, channels_failed, COALESCE(error, ''),
+			posts_seen, posts_new, posts_updated, posts_duplicate, posts_dropped, posts_stop_words
+		FROM scrape_runs
+		ORDER BY started_at DESC, id DESC
+		LIMIT NULLIF($1, 0)
+	`
+
+	if limit < 0 {
+		limit = 0
+	}
+
+	rows, err := r.db.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+	defer rows.Close()
+
+	var runs []model.RunReport
+	for rows.Next() {
+		var run model.RunReport
+		err := rows.Scan(&run.ID, &run.Parser, &run.StartedAt, &run.FinishedAt, &run.ChannelsFailed, &run.Error,
+			&run.Seen, &run.New, &run.Updated, &run.Duplicates, &run.Dropped, &run.StopWords)
+		if err != nil {
+			return nil, fmt.Errorf("%s: сканирование строки: %w", op, err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: итерация по результатам: %w", op, err)
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,91 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// GetStats возвращает сводную статистику по вакансиям, их статусам, технологиям и каналам
+func (r *repository) GetStats(ctx context.Context) (model.Stats, error) {
+	op := "repository.jobs.GetStats"
+
+	var stats model.Stats
+
+	jobsQuery := `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE status = $1),
+			COUNT(*) FILTER (WHERE status = $1 AND main_technology IS NOT NULL AND main_technology != ''),
+			COUNT(*) FILTER (WHERE date_parsed > NOW() - INTERVAL '1 day')
+		FROM jobs_raw
+	`
+
+	err := r.db.QueryRow(ctx, jobsQuery, model.JobStatusActive).
+		Scan(&stats.JobsTotal, &stats.JobsActive, &stats.JobsClassified, &stats.JobsLastDay)
+	if err != nil {
+		return stats, fmt.Errorf("%s: статистика вакансий: %w", op, err)
+	}
+
+	statusesQuery := `
+		SELECT status, COUNT(*) AS cnt
+		FROM jobs_raw
+		GROUP BY status
+		ORDER BY cnt DESC, status
+	`
+
+	statusRows, err := r.db.Query(ctx, statusesQuery)
+	if err != nil {
+		return stats, fmt.Errorf("%s: статистика статусов: %w", op, err)
+	}
+	defer statusRows.Close()
+
+	for statusRows.Next() {
+		var sc model.StatusCount
+		if err := statusRows.Scan(&sc.Status, &sc.Count); err != nil {
+			return stats, fmt.Errorf("%s: сканирование строки статусов: %w", op, err)
+		}
+		stats.Statuses = append(stats.Statuses, sc)
+	}
+
+	if err := statusRows.Err(); err != nil {
+		return stats, fmt.Errorf("%s: итерация по статусам: %w", op, err)
+	}
+	statusRows.Close()
+
+	channelsQuery := `SELECT COUNT(*), MAX(date_last_parsed) FROM telegram_channels`
+
+	err = r.db.QueryRow(ctx, channelsQuery).Scan(&stats.ChannelsTotal, &stats.LastParsed)
+	if err != nil {
+		return stats, fmt.Errorf("%s: статистика каналов: %w", op, err)
+	}
+
+	technologiesQuery := `
+		SELECT main_technology, COUNT(*) AS cnt
+		FROM jobs_raw
+		WHERE status = $1 AND main_technology IS NOT NULL AND main_technology != ''
+		GROUP BY main_technology
+		ORDER BY cnt DESC, main_technology
+	`
+
+	rows, err := r.db.Query(ctx, technologiesQuery, model.JobStatusActive)
+	if err != nil {
+		return stats, fmt.Errorf("%s: статистика технологий: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tc model.TechnologyCount
+		if err := rows.Scan(&tc.Technology, &tc.Count); err != nil {
+			return stats, fmt.Errorf("%s: сканирование строки: %w", op, err)
+		}
+		stats.Technologies = append(stats.Technologies, tc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return stats, fmt.Errorf("%s: итерация по результатам: %w", op, err)
+	}
+
+	return stats, nil
+}
@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Masterminds/squirrel"
@@ -8,7 +9,7 @@ import (
 )
 
 // GetStopWords возвращает список стоп-слов из базы данных
-func (r *repository) GetStopWords() ([]model.StopWord, error) {
+func (r *repository) GetStopWords(ctx context.Context) ([]model.StopWord, error) {
 	op := "repository.jobs.GetStopWords"
 
 	// Создаем билдер запросов SQL с указанием формата плейсхолдеров для PostgreSQL
@@ -25,7 +26,7 @@ func (r *repository) GetStopWords() ([]model.StopWord, error) {
 	}
 
 	// Выполняем запрос
-	rows, err := r.db.Query(r.context, sql, args...)
+	rows, err := r.db.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: выполнение запроса: %w", op, err)
 	}
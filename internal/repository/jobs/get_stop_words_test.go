@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,13 +14,14 @@ import (
 func TestGetStopWords(t *testing.T) {
 	// GIVEN: Создаем тестовый логгер
 	logger := zaptest.NewLogger(t, zaptest.Level(zap.InfoLevel))
+	ctx := context.Background()
 
 	t.Run("успешное получение стоп-слов", func(t *testing.T) {
 		// GIVEN: Мокируем репозиторий со стоп-словами
 		mockRepo := test.NewMockRepository(logger)
 
 		// WHEN: Вызываем метод получения стоп-слов
-		stopWords, err := mockRepo.GetStopWords()
+		stopWords, err := mockRepo.GetStopWords(ctx)
 
 		// THEN: Проверяем, что стоп-слова получены успешно
 		assert.NoError(t, err)
@@ -35,7 +37,7 @@ func TestGetStopWords(t *testing.T) {
 		mockRepo.ShouldError = true
 
 		// WHEN: Вызываем метод получения стоп-слов
-		stopWords, err := mockRepo.GetStopWords()
+		stopWords, err := mockRepo.GetStopWords(ctx)
 
 		// THEN: Проверяем, что возникла ошибка
 		assert.Error(t, err)
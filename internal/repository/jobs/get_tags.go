@@ -0,0 +1,51 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// GetTags возвращает хэштеги вакансий по убыванию количества активных вакансий вместе со связанными технологиями.
+// limit <= 0 означает без ограничения
+func (r *repository) GetTags(ctx context.Context, limit int) ([]model.TagCount, error) {
+	op := "repository.jobs.GetTags"
+
+	query := `
+		SELECT t.name, COALESCE(tech.technology, ''), COUNT(j.id) AS cnt
+		FROM tags t
+		LEFT JOIN job_tags jt ON jt.tag_id = t.id
+		LEFT JOIN jobs_raw j ON j.id = jt.job_id AND j.status = $2
+		LEFT JOIN tag_aliases a ON a.tag = t.name
+		LEFT JOIN technologies tech ON tech.id = a.technology_id
+		GROUP BY t.name, tech.technology
+		ORDER BY cnt DESC, t.name
+		LIMIT NULLIF($1, 0)
+	`
+
+	if limit < 0 {
+		limit = 0
+	}
+
+	rows, err := r.db.Query(ctx, query, limit, model.JobStatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+	defer rows.Close()
+
+	var tags []model.TagCount
+	for rows.Next() {
+		var tc model.TagCount
+		if err := rows.Scan(&tc.Tag, &tc.Technology, &tc.Count); err != nil {
+			return nil, fmt.Errorf("%s: сканирование строки: %w", op, err)
+		}
+		tags = append(tags, tc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: итерация по результатам: %w", op, err)
+	}
+
+	return tags, nil
+}
@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Masterminds/squirrel"
@@ -8,15 +9,17 @@ import (
 )
 
 // GetTechnologies возвращает список технологий из базы данных, отсортированный по приоритету (sort_order)
-func (r *repository) GetTechnologies() ([]model.Technology, error) {
+func (r *repository) GetTechnologies(ctx context.Context) ([]model.Technology, error) {
 	op := "repository.jobs.GetTechnologies"
 
 	// Создаем билдер запросов SQL с указанием формата плейсхолдеров для PostgreSQL
 	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
-	// Формируем SELECT запрос с сортировкой по sort_order
+	// Формируем SELECT запрос с сортировкой по sort_order; хэштеги технологии собираем из tag_aliases,
+	// count - количество активных вакансий на момент последнего пересчета
 	sql, args, err := psql.
-		Select("id", "technology", "keywords", "sort_order").
+		Select("id", "technology", "keywords", "sort_order",
+			"COALESCE((SELECT array_agg(a.tag ORDER BY a.tag) FROM tag_aliases a WHERE a.technology_id = technologies.id), '{}')", "count").
 		From("technologies").
 		OrderBy("sort_order ASC").
 		ToSql()
@@ -26,7 +29,7 @@ func (r *repository) GetTechnologies() ([]model.Technology, error) {
 	}
 
 	// Выполняем запрос
-	rows, err := r.db.Query(r.context, sql, args...)
+	rows, err := r.db.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: выполнение запроса: %w", op, err)
 	}
@@ -43,6 +46,8 @@ func (r *repository) GetTechnologies() ([]model.Technology, error) {
 			&tech.Technology,
 			&keywordsArray,
 			&tech.SortOrder,
+			&tech.Tags,
+			&tech.Count,
 		)
 
 		if err != nil {
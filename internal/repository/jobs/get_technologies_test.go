@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,7 @@ import (
 func TestGetTechnologies(t *testing.T) {
 	// GIVEN: Создаем тестовый логгер
 	logger := zaptest.NewLogger(t, zaptest.Level(zap.InfoLevel))
+	ctx := context.Background()
 
 	t.Run("успешное получение технологий", func(t *testing.T) {
 		// GIVEN: Мокируем репозиторий с технологиями
@@ -26,7 +28,7 @@ func TestGetTechnologies(t *testing.T) {
 
 		// Используем мок-репозиторий напрямую, так как мы не можем
 		// подменить реальную базу данных в методе GetTechnologies
-		technologies, err := mockRepo.GetTechnologies()
+		technologies, err := mockRepo.GetTechnologies(ctx)
 
 		// THEN: Проверяем, что технологии получены успешно
 		assert.NoError(t, err)
@@ -42,7 +44,7 @@ func TestGetTechnologies(t *testing.T) {
 		mockRepo.ShouldError = true
 
 		// WHEN: Вызываем метод получения технологий
-		technologies, err := mockRepo.GetTechnologies()
+		technologies, err := mockRepo.GetTechnologies(ctx)
 
 		// THEN: Проверяем, что возникла ошибка
 		assert.Error(t, err)
@@ -58,7 +60,7 @@ func TestGetTechnologies(t *testing.T) {
 		// mockRepo := test.NewMockRepository(logger)
 
 		// WHEN: Вызываем метод получения технологий
-		// technologies, err := mockRepo.GetTechnologies()
+		// technologies, err := mockRepo.GetTechnologies(ctx)
 
 		// THEN: Проверяем, что получен пустой список без ошибок
 		// assert.NoError(t, err)
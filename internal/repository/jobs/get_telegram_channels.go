@@ -1,13 +1,15 @@
 package jobs
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/zalhonan/remotejobs-web-scraper/model"
 )
 
-func (r *repository) GetTelegramChannels() ([]model.TelegramChannel, error) {
+// GetTelegramChannels возвращает включенные Telegram-каналы; отключенные каналы не собираются
+func (r *repository) GetTelegramChannels(ctx context.Context) ([]model.TelegramChannel, error) {
 	op := "repository.jobs.GetTelegramChannels"
 
 	// Создаем билдер запросов SQL с указанием формата плейсхолдеров для PostgreSQL
@@ -17,6 +19,7 @@ func (r *repository) GetTelegramChannels() ([]model.TelegramChannel, error) {
 	sql, args, err := psql.
 		Select("id", "tag", "last_post_id", "date_channel_added", "posts_parsed", "date_last_parsed").
 		From("telegram_channels").
+		Where(squirrel.Eq{"enabled": true}).
 		ToSql()
 
 	if err != nil {
@@ -24,7 +27,7 @@ func (r *repository) GetTelegramChannels() ([]model.TelegramChannel, error) {
 	}
 
 	// Выполняем запрос
-	rows, err := r.db.Query(r.context, sql, args...)
+	rows, err := r.db.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: выполнение запроса: %w", op, err)
 	}
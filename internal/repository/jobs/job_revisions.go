@@ -0,0 +1,26 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// insertJobRevision сохраняет в job_revisions текущий текст вакансии из поста channel/postID,
+// если он отличается от текста job. Для нового поста ничего не делает
+func insertJobRevision(ctx context.Context, tx pgx.Tx, channel string, postID int64, job model.JobRaw) error {
+	query := `
+		INSERT INTO job_revisions (job_id, title, content, content_pure, date_revised)
+		SELECT id, title, content, content_pure, $5
+		FROM jobs_raw
+		WHERE source = $1 AND channel = $2 AND post_id = $3 AND content IS DISTINCT FROM $4
+	`
+
+	if _, err := tx.Exec(ctx, query, sourceTelegram, channel, postID, job.Content, job.DateParsed); err != nil {
+		return fmt.Errorf("сохранение прежней версии поста %s/%d: %w", channel, postID, err)
+	}
+
+	return nil
+}
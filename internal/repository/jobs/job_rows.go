@@ -0,0 +1,166 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// jobColumns - колонки jobs_raw, которые читает scanJob, в порядке сканирования
+var jobColumns = slices.Concat(
+	[]string{
+		"id", "content", "COALESCE(title, '')", "COALESCE(content_pure, '')", "source_link",
+		"COALESCE(main_technology, '')", "slug", "COALESCE(stop_words, '{}')", "date_posted", "date_parsed", "status",
+	},
+	detailsColumns,
+)
+
+// scanJob читает вакансию из строки с колонками jobColumns; технологии и хэштеги заполняет loadJobRelations
+func scanJob(row pgx.Row) (model.JobRaw, error) {
+	var job model.JobRaw
+
+	var salaryMin, salaryMax, salaryMinBase, salaryMaxBase *int64
+	var salaryCurrency, salaryPeriod, salaryTax *string
+	var workFormat, company *string
+	var simHash *int64
+
+	err := row.Scan(
+		&job.ID,
+		&job.Content,
+		&job.Title,
+		&job.ContentPure,
+		&job.SourceLink,
+		&job.MainTechnology,
+		&job.Slug,
+		&job.StopWords,
+		&job.DatePosted,
+		&job.DateParsed,
+		&job.Status,
+		&salaryMin,
+		&salaryMax,
+		&salaryCurrency,
+		&salaryPeriod,
+		&salaryTax,
+		&salaryMinBase,
+		&salaryMaxBase,
+		&workFormat,
+		&job.Location.Relocation,
+		&job.Location.Countries,
+		&job.Location.Cities,
+		&job.Location.TimezoneMin,
+		&job.Location.TimezoneMax,
+		&job.Seniority,
+		&job.EmploymentType,
+		&company,
+		&job.Contacts.Telegram,
+		&job.Contacts.Emails,
+		&job.Contacts.Phones,
+		&job.Contacts.ApplyURLs,
+		&simHash,
+	)
+	if err != nil {
+		return job, err
+	}
+
+	if salaryMin != nil || salaryMax != nil {
+		job.Salary = &model.Salary{
+			Min:      valueOrZero(salaryMin),
+			Max:      valueOrZero(salaryMax),
+			Currency: valueOrZero(salaryCurrency),
+			Period:   valueOrZero(salaryPeriod),
+			Tax:      valueOrZero(salaryTax),
+			MinBase:  valueOrZero(salaryMinBase),
+			MaxBase:  valueOrZero(salaryMaxBase),
+		}
+	}
+
+	job.Location.WorkFormat = valueOrZero(workFormat)
+	job.Contacts.Company = valueOrZero(company)
+	job.SimHash = uint64(valueOrZero(simHash))
+
+	return job, nil
+}
+
+// loadJobRelations заполняет технологии и хэштеги вакансий двумя запросами на всю пачку
+func (r *repository) loadJobRelations(ctx context.Context, jobs []model.JobRaw) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(jobs))
+	index := make(map[int64]int, len(jobs))
+	for i, job := range jobs {
+		ids[i] = job.ID
+		index[job.ID] = i
+	}
+
+	// Технологии - по убыванию релевантности, как их возвращает классификатор
+	rows, err := r.db.Query(ctx, `
+		SELECT jt.job_id, t.id, t.technology, jt.score
+		FROM job_technologies jt
+		JOIN technologies t ON t.id = jt.technology_id
+		WHERE jt.job_id = ANY($1)
+		ORDER BY jt.job_id, jt.score DESC, t.sort_order
+	`, ids)
+	if err != nil {
+		return fmt.Errorf("выборка технологий вакансий: %w", err)
+	}
+
+	for rows.Next() {
+		var jobID int64
+		var tech model.JobTechnology
+		if err := rows.Scan(&jobID, &tech.TechnologyID, &tech.Technology, &tech.Score); err != nil {
+			rows.Close()
+			return fmt.Errorf("сканирование технологии вакансии: %w", err)
+		}
+
+		job := &jobs[index[jobID]]
+		job.Technologies = append(job.Technologies, tech)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("итерация по технологиям вакансий: %w", err)
+	}
+
+	rows, err = r.db.Query(ctx, `
+		SELECT jt.job_id, t.name
+		FROM job_tags jt
+		JOIN tags t ON t.id = jt.tag_id
+		WHERE jt.job_id = ANY($1)
+		ORDER BY jt.job_id, t.name
+	`, ids)
+	if err != nil {
+		return fmt.Errorf("выборка хэштегов вакансий: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var jobID int64
+		var tag string
+		if err := rows.Scan(&jobID, &tag); err != nil {
+			return fmt.Errorf("сканирование хэштега вакансии: %w", err)
+		}
+
+		job := &jobs[index[jobID]]
+		job.Tags = append(job.Tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("итерация по хэштегам вакансий: %w", err)
+	}
+
+	return nil
+}
+
+// valueOrZero возвращает значение по указателю или нулевое значение для nil, то есть NULL в БД
+func valueOrZero[T any](value *T) T {
+	var zero T
+	if value == nil {
+		return zero
+	}
+	return *value
+}
@@ -0,0 +1,77 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
+)
+
+// insertJobTags сохраняет хэштеги вакансии в tags и связывает их с вакансией в job_tags
+func insertJobTags(ctx context.Context, tx pgx.Tx, jobID int64, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	insert := psql.
+		Insert("tags").
+		Columns("name")
+	for _, tag := range tags {
+		insert = insert.Values(tag)
+	}
+
+	query, args, err := insert.
+		Suffix("ON CONFLICT (name) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("формирование запроса хэштегов: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("сохранение хэштегов вакансии %d: %w", jobID, err)
+	}
+
+	query, args, err = psql.
+		Insert("job_tags").
+		Columns("job_id", "tag_id").
+		Select(psql.
+			Select().
+			Column("?::bigint", jobID).
+			Column("id").
+			From("tags").
+			Where("name = ANY(?)", pq.Array(tags))).
+		Suffix("ON CONFLICT (job_id, tag_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("формирование запроса хэштегов вакансии: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("связывание хэштегов с вакансией %d: %w", jobID, err)
+	}
+
+	return nil
+}
+
+// replaceJobTags заменяет хэштеги вакансии в job_tags на переданные
+func replaceJobTags(ctx context.Context, tx pgx.Tx, jobID int64, tags []string) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Delete("job_tags").
+		Where(squirrel.Eq{"job_id": jobID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("формирование запроса удаления хэштегов вакансии: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("удаление хэштегов вакансии %d: %w", jobID, err)
+	}
+
+	return insertJobTags(ctx, tx, jobID, tags)
+}
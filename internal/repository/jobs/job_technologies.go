@@ -0,0 +1,58 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// insertJobTechnologies сохраняет найденные технологии вакансии в job_technologies
+func insertJobTechnologies(ctx context.Context, tx pgx.Tx, jobID int64, technologies []model.JobTechnology) error {
+	if len(technologies) == 0 {
+		return nil
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	insert := psql.
+		Insert("job_technologies").
+		Columns("job_id", "technology_id", "score")
+	for _, tech := range technologies {
+		insert = insert.Values(jobID, tech.TechnologyID, tech.Score)
+	}
+
+	query, args, err := insert.
+		Suffix("ON CONFLICT (job_id, technology_id) DO UPDATE SET score = EXCLUDED.score").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("формирование запроса технологий вакансии: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("сохранение технологий вакансии %d: %w", jobID, err)
+	}
+
+	return nil
+}
+
+// replaceJobTechnologies заменяет технологии вакансии в job_technologies на переданные
+func replaceJobTechnologies(ctx context.Context, tx pgx.Tx, jobID int64, technologies []model.JobTechnology) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Delete("job_technologies").
+		Where(squirrel.Eq{"job_id": jobID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("формирование запроса удаления технологий вакансии: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("удаление технологий вакансии %d: %w", jobID, err)
+	}
+
+	return insertJobTechnologies(ctx, tx, jobID, technologies)
+}
@@ -0,0 +1,120 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// defaultListLimit - размер страницы ListJobs, если он не задан
+const defaultListLimit = 50
+
+// searchQueryExpr - поисковый запрос в синтаксисе websearch_to_tsquery для колонки search_vector:
+// слова, "фраза", -исключение, or. Оба плейсхолдера - текст запроса
+const searchQueryExpr = "(websearch_to_tsquery('russian', ?) || websearch_to_tsquery('english', ?))"
+
+// ListJobs возвращает страницу вакансий по фильтру, начиная с самых новых.
+// Страницы строятся по курсору (date_posted, id), поэтому новые вакансии не сдвигают уже выданные страницы
+func (r *repository) ListJobs(ctx context.Context, filter model.JobFilter) (model.JobsPage, error) {
+	op := "repository.jobs.ListJobs"
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	// Лишняя строка показывает, что за страницей есть продолжение
+	builder := filterJobs(psql.Select(jobColumns...).From("jobs_raw j"), filter).
+		OrderBy("date_posted DESC", "id DESC").
+		Limit(uint64(limit) + 1)
+
+	if query := strings.TrimSpace(filter.Query); query != "" {
+		builder = builder.Where("j.search_vector @@ "+searchQueryExpr, query, query)
+	}
+
+	if filter.After != nil {
+		builder = builder.Where("(date_posted, id) < (?, ?)", filter.After.DatePosted, filter.After.ID)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return model.JobsPage{}, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.JobsPage{}, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+	defer rows.Close()
+
+	page := model.JobsPage{Jobs: make([]model.JobRaw, 0, limit)}
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return model.JobsPage{}, fmt.Errorf("%s: сканирование строки: %w", op, err)
+		}
+		page.Jobs = append(page.Jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return model.JobsPage{}, fmt.Errorf("%s: итерация по результатам: %w", op, err)
+	}
+	rows.Close()
+
+	if len(page.Jobs) > limit {
+		page.Jobs = page.Jobs[:limit]
+		last := page.Jobs[limit-1]
+		page.Next = &model.JobCursor{DatePosted: last.DatePosted, ID: last.ID}
+	}
+
+	if err := r.loadJobRelations(ctx, page.Jobs); err != nil {
+		return model.JobsPage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return page, nil
+}
+
+// filterJobs добавляет к выборке из jobs_raw с псевдонимом j условия фильтра по статусу, технологии,
+// хэштегу, каналу и дате публикации. Текст запроса и курсор учитывает вызывающий метод
+func filterJobs(builder squirrel.SelectBuilder, filter model.JobFilter) squirrel.SelectBuilder {
+	statuses := filter.Statuses
+	if len(statuses) == 0 {
+		statuses = []string{model.JobStatusActive}
+	}
+
+	builder = builder.Where(squirrel.Eq{"j.status": statuses})
+
+	if filter.Technology != "" {
+		builder = builder.Where(`EXISTS (
+			SELECT 1 FROM job_technologies jt
+			JOIN technologies t ON t.id = jt.technology_id
+			WHERE jt.job_id = j.id AND lower(t.technology) = lower(?)
+		)`, filter.Technology)
+	}
+
+	if filter.Tag != "" {
+		builder = builder.Where(`EXISTS (
+			SELECT 1 FROM job_tags jt
+			JOIN tags t ON t.id = jt.tag_id
+			WHERE jt.job_id = j.id AND t.name = ?
+		)`, strings.ToLower(strings.TrimPrefix(filter.Tag, "#")))
+	}
+
+	if filter.Channel != "" {
+		builder = builder.Where(squirrel.Eq{"j.source": sourceTelegram, "j.channel": strings.TrimPrefix(filter.Channel, "@")})
+	}
+
+	if !filter.From.IsZero() {
+		builder = builder.Where(squirrel.GtOrEq{"j.date_posted": filter.From})
+	}
+	if !filter.To.IsZero() {
+		builder = builder.Where(squirrel.Lt{"j.date_posted": filter.To})
+	}
+
+	return builder
+}
@@ -0,0 +1,133 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// AddChannel добавляет Telegram-канал по тегу; возвращает false, если канал уже есть в БД
+func (r *repository) AddChannel(ctx context.Context, tag string) (bool, error) {
+	op := "repository.jobs.AddChannel"
+
+	if !channelTagRegexp.MatchString(tag) {
+		return false, fmt.Errorf("%s: %w: некорректный тег канала %q", op, model.ErrInvalidInput, tag)
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Insert("telegram_channels").
+		Columns("tag", "last_post_id").
+		Values(tag, nil).
+		Suffix("ON CONFLICT (tag) DO NOTHING").
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// RemoveChannel удаляет Telegram-канал по тегу; собранные из него вакансии остаются в БД.
+// Возвращает false, если канала не было
+func (r *repository) RemoveChannel(ctx context.Context, tag string) (bool, error) {
+	op := "repository.jobs.RemoveChannel"
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Delete("telegram_channels").
+		Where(squirrel.Eq{"tag": tag}).
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// RewindChannel устанавливает last_post_id канала, чтобы следующий сбор заново прошел посты после postID.
+// Повторно собранные посты обновляют уже сохраненные вакансии, а не создают новые.
+// Возвращает false, если канала нет в БД
+func (r *repository) RewindChannel(ctx context.Context, tag string, postID int64) (bool, error) {
+	op := "repository.jobs.RewindChannel"
+
+	if postID < 0 {
+		return false, fmt.Errorf("%s: %w: некорректный ID поста %d", op, model.ErrInvalidInput, postID)
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Update("telegram_channels").
+		Set("last_post_id", postID).
+		Where(squirrel.Eq{"tag": tag}).
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// SetChannelEnabled вручную включает или отключает Telegram-канал. При включении сбрасываются
+// найденные проблемы, а ошибки и простой до включения больше не учитываются проверкой здоровья.
+// Возвращает false, если канала нет в БД
+func (r *repository) SetChannelEnabled(ctx context.Context, tag string, enabled bool) (bool, error) {
+	op := "repository.jobs.SetChannelEnabled"
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	update := psql.
+		Update("telegram_channels").
+		Set("enabled", enabled).
+		Where(squirrel.Eq{"tag": tag})
+
+	if enabled {
+		update = update.
+			Set("health_issues", squirrel.Expr("'{}'")).
+			Set("disabled_reason", nil).
+			Set("date_disabled", nil).
+			Set("date_enabled", squirrel.Expr("NOW()"))
+	} else {
+		update = update.
+			Set("disabled_reason", channelDisabledManually).
+			Set("date_disabled", squirrel.Expr("NOW()"))
+	}
+
+	query, args, err := update.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// channelDisabledManually - причина отключения канала вручную в telegram_channels.disabled_reason
+const channelDisabledManually = "manual"
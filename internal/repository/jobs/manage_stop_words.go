@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// AddStopWord добавляет стоп-слово; оно учитывается со следующего сбора, сохраненные вакансии
+// пересчитывает команда reclassify. Возвращает false, если такое стоп-слово уже есть
+func (r *repository) AddStopWord(ctx context.Context, word string) (bool, error) {
+	op := "repository.jobs.AddStopWord"
+
+	word = strings.TrimSpace(word)
+	if word == "" {
+		return false, fmt.Errorf("%s: %w: пустое стоп-слово", op, model.ErrInvalidInput)
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Insert("stop_words").
+		Columns("word").
+		Values(word).
+		Suffix("ON CONFLICT (word) DO NOTHING").
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// RemoveStopWord удаляет стоп-слово; возвращает false, если его не было
+func (r *repository) RemoveStopWord(ctx context.Context, word string) (bool, error) {
+	op := "repository.jobs.RemoveStopWord"
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Delete("stop_words").
+		Where(squirrel.Eq{"word": strings.TrimSpace(word)}).
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
@@ -0,0 +1,104 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/extract"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// Допустимый диапазон technologies.sort_order
+const (
+	minSortOrder = -100
+	maxSortOrder = 100
+)
+
+// SaveTechnology добавляет технологию или заменяет ключевые слова и sort_order существующей.
+// Без ключевых слов технология ищется по названию. Новые настройки применяются к следующему сбору,
+// сохраненные вакансии пересчитывает команда reclassify. Возвращает true, если технология добавлена
+func (r *repository) SaveTechnology(ctx context.Context, tech model.Technology) (bool, error) {
+	op := "repository.jobs.SaveTechnology"
+
+	tech.Technology = strings.TrimSpace(tech.Technology)
+	if tech.Technology == "" {
+		return false, fmt.Errorf("%s: %w: не задано название технологии", op, model.ErrInvalidInput)
+	}
+
+	if tech.SortOrder < minSortOrder || tech.SortOrder > maxSortOrder {
+		return false, fmt.Errorf("%s: %w: sort_order должен быть от %d до %d", op, model.ErrInvalidInput, minSortOrder, maxSortOrder)
+	}
+
+	var keywords []string
+	var errs []error
+	for _, keyword := range tech.Keywords {
+		keyword = strings.TrimSpace(keyword)
+		if keyword == "" {
+			continue
+		}
+		if _, err := extract.CompileKeyword(keyword); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		keywords = append(keywords, keyword)
+	}
+
+	// В отличие от импорта из файла некорректные ключевые слова не пропускаются молча
+	if err := errors.Join(errs...); err != nil {
+		return false, fmt.Errorf("%s: %w: %w", op, model.ErrInvalidInput, err)
+	}
+
+	if len(keywords) == 0 {
+		keywords = []string{tech.Technology}
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	// xmax = 0 только у строки, вставленной этим запросом, а не обновленной по конфликту
+	query, args, err := psql.
+		Insert("technologies").
+		Columns("technology", "keywords", "sort_order").
+		Values(tech.Technology, pq.Array(keywords), tech.SortOrder).
+		Suffix("ON CONFLICT (technology) DO UPDATE SET keywords = EXCLUDED.keywords, sort_order = EXCLUDED.sort_order").
+		Suffix("RETURNING xmax = 0").
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	var inserted bool
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&inserted); err != nil {
+		return false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return inserted, nil
+}
+
+// RemoveTechnology удаляет технологию вместе со связями вакансий и хэштегов; основная технология
+// сохраненных вакансий остается до команды reclassify. Возвращает false, если технологии не было
+func (r *repository) RemoveTechnology(ctx context.Context, technology string) (bool, error) {
+	op := "repository.jobs.RemoveTechnology"
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Delete("technologies").
+		Where(squirrel.Eq{"technology": technology}).
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
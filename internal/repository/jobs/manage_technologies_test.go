@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSaveTechnologyInvalid(t *testing.T) {
+	// GIVEN: Репозиторий без подключения к БД
+	repo := NewRepository(nil, zaptest.NewLogger(t), DefaultConfig())
+
+	for name, tech := range map[string]model.Technology{
+		"пустое название":             {Technology: " ", Keywords: []string{"go"}},
+		"sort_order вне диапазона":    {Technology: "golang", SortOrder: 101},
+		"некорректное ключевое слово": {Technology: "golang", Keywords: []string{"go", "re:(unclosed"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// WHEN: Сохраняем технологию с некорректными данными
+			created, err := repo.SaveTechnology(context.Background(), tech)
+
+			// THEN: Технология отклонена до обращения к БД
+			assert.ErrorIs(t, err, model.ErrInvalidInput)
+			assert.False(t, created)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/utils"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap"
+)
+
+// MarkDeletedJobs помечает удаленными вакансии канала из просмотренного диапазона постов,
+// которых не оказалось среди заново собранных. Вакансии со статусом, назначенным вручную, не меняются.
+// Возвращает количество помеченных вакансий
+func (r *repository) MarkDeletedJobs(ctx context.Context, revisit model.RevisitedChannel) (int, error) {
+	op := "repository.jobs.MarkDeletedJobs"
+
+	// Неполный обход: не знаем, какие посты были просмотрены
+	if revisit.FromPostID <= 0 || revisit.ToPostID < revisit.FromPostID {
+		return 0, nil
+	}
+
+	seen := make([]int64, 0, len(revisit.Jobs))
+	for _, job := range revisit.Jobs {
+		if postID, err := utils.PostIDFromLink(job.SourceLink); err == nil {
+			seen = append(seen, postID)
+		}
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Update("jobs_raw").
+		Set("status", model.JobStatusDeleted).
+		Set("date_closed", squirrel.Expr("COALESCE(date_closed, NOW())")).
+		Where(squirrel.Eq{"source": sourceTelegram, "channel": revisit.Channel}).
+		Where("post_id BETWEEN ? AND ?", revisit.FromPostID, revisit.ToPostID).
+		Where("NOT (post_id = ANY(?))", pq.Array(seen)).
+		Where(squirrel.NotEq{"status": append([]string{model.JobStatusDeleted}, model.ModerationStatuses...)}).
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	deleted := int(result.RowsAffected())
+	if deleted > 0 {
+		r.logger.Info("Вакансии удалены из канала",
+			zap.String("channel", revisit.Channel),
+			zap.Int("count", deleted))
+	}
+
+	return deleted, nil
+}
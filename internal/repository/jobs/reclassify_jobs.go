@@ -0,0 +1,186 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/extract"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap"
+)
+
+// ReclassifyJobs заново определяет технологии, основную технологию и стоп-слова для всех сохраненных вакансий
+// по текущим спискам технологий и стоп-слов. Слаги не меняются. Возвращает количество измененных вакансий
+func (r *repository) ReclassifyJobs(ctx context.Context) (int, error) {
+	op := "repository.jobs.ReclassifyJobs"
+
+	technologies, err := r.GetTechnologies(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stopWords, err := r.GetStopWords(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	classifier := newClassifier(technologies, stopWords, r.logger)
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	currentTechnologies, err := r.getJobTechnologies(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query, args, err := psql.
+		Select("id", "COALESCE(title, '')", "content", "COALESCE(main_technology, '')", "COALESCE(stop_words, '{}')").
+		From("jobs_raw").
+		OrderBy("id").
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	type change struct {
+		id             int64
+		mainTechnology string
+		stopWords      []string
+		technologies   []model.JobTechnology
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+	defer rows.Close()
+
+	var changes []change
+	for rows.Next() {
+		var id int64
+		var job model.JobRaw
+		var currentStopWords []string
+
+		if err := rows.Scan(&id, &job.Title, &job.Content, &job.MainTechnology, &currentStopWords); err != nil {
+			return 0, fmt.Errorf("%s: сканирование строки: %w", op, err)
+		}
+
+		mainTechnology := job.MainTechnology
+		job.Tags = extract.Hashtags(job.Content)
+		classifier.classify(&job)
+
+		if job.MainTechnology != mainTechnology ||
+			!slices.Equal(job.StopWords, currentStopWords) ||
+			!sameTechnologies(job.Technologies, currentTechnologies[id]) {
+			changes = append(changes, change{
+				id:             id,
+				mainTechnology: job.MainTechnology,
+				stopWords:      job.StopWords,
+				technologies:   job.Technologies,
+			})
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("%s: итерация по результатам: %w", op, err)
+	}
+	rows.Close()
+
+	if len(changes) == 0 {
+		return 0, nil
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: начало транзакции: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, c := range changes {
+		updateQuery, updateArgs, err := psql.
+			Update("jobs_raw").
+			Set("main_technology", c.mainTechnology).
+			Set("stop_words", squirrel.Expr("?::text[]", pq.Array(c.stopWords))).
+			Where(squirrel.Eq{"id": c.id}).
+			ToSql()
+
+		if err != nil {
+			return 0, fmt.Errorf("%s: формирование запроса обновления: %w", op, err)
+		}
+
+		if _, err := tx.Exec(ctx, updateQuery, updateArgs...); err != nil {
+			return 0, fmt.Errorf("%s: обновление вакансии %d: %w", op, c.id, err)
+		}
+
+		if err := replaceJobTechnologies(ctx, tx, c.id, c.technologies); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: завершение транзакции: %w", op, err)
+	}
+
+	r.logger.Info("Вакансии переклассифицированы", zap.Int("changed", len(changes)))
+
+	return len(changes), nil
+}
+
+// getJobTechnologies возвращает сохраненные технологии вакансий: job_id -> technology_id -> score
+func (r *repository) getJobTechnologies(ctx context.Context) (map[int64]map[int64]int, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Select("job_id", "technology_id", "score").
+		From("job_technologies").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("формирование запроса технологий вакансий: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("выполнение запроса технологий вакансий: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[int64]map[int64]int)
+	for rows.Next() {
+		var jobID, technologyID int64
+		var score int
+
+		if err := rows.Scan(&jobID, &technologyID, &score); err != nil {
+			return nil, fmt.Errorf("сканирование технологий вакансий: %w", err)
+		}
+
+		if result[jobID] == nil {
+			result[jobID] = make(map[int64]int)
+		}
+		result[jobID][technologyID] = score
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("итерация по технологиям вакансий: %w", err)
+	}
+
+	return result, nil
+}
+
+// sameTechnologies сравнивает найденные технологии с сохраненными
+func sameTechnologies(found []model.JobTechnology, saved map[int64]int) bool {
+	if len(found) != len(saved) {
+		return false
+	}
+
+	for _, tech := range found {
+		if score, ok := saved[tech.TechnologyID]; !ok || score != tech.Score {
+			return false
+		}
+	}
+
+	return true
+}
@@ -4,77 +4,68 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/extract"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
 	"go.uber.org/zap"
 )
 
 type repository struct {
-	db      *pgxpool.Pool
-	logger  *zap.Logger
-	context context.Context
+	db              *pgxpool.Pool
+	logger          *zap.Logger
+	config          Config
+	seniority       *extract.Dictionary
+	employmentTypes *extract.Dictionary
+	// closing - словарь из одного значения model.JobStatusClosed с фразами о закрытии вакансии
+	closing *extract.Dictionary
 }
 
-func NewRepository(db *pgxpool.Pool, logger *zap.Logger, ctx context.Context) *repository {
-	return &repository{
-		db:      db,
-		logger:  logger,
-		context: ctx,
+func NewRepository(db *pgxpool.Pool, logger *zap.Logger, config Config) *repository {
+	// Некорректные ключевые слова словарей пропускаются, остальные продолжают работать
+	seniority, err := extract.NewDictionary(config.Seniority)
+	if err != nil {
+		logger.Warn("Некорректные ключевые слова в словаре грейдов", zap.Error(err))
 	}
-}
-
-func (r *repository) UpdateTechnologiesCount() error {
-	op := "repository.jobs.UpdateTechnologiesCount"
-
-	// Формируем запрос с использованием squirrel
-	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
-
-	// Получаем данные для обновления (технологии и их количество)
-	subQuery, subArgs, err := psql.
-		Select("main_technology", "COUNT(*) as cnt").
-		From("jobs_raw").
-		Where(squirrel.And{
-			squirrel.NotEq{"main_technology": nil},
-			squirrel.NotEq{"main_technology": ""},
-		}).
-		GroupBy("main_technology").
-		ToSql()
 
+	employmentTypes, err := extract.NewDictionary(config.EmploymentTypes)
 	if err != nil {
-		return fmt.Errorf("%s: формирование SQL подзапроса: %w", op, err)
+		logger.Warn("Некорректные ключевые слова в словаре типов занятости", zap.Error(err))
 	}
 
-	// Строим запрос для обновления счётчиков в технологиях
-	query := fmt.Sprintf(`
-		UPDATE technologies t
-		SET count = sub.cnt
-		FROM (%s) AS sub
-		WHERE t.technology = sub.main_technology
-	`, subQuery)
-
-	// Выполняем обновление
-	_, err = r.db.Exec(r.context, query, subArgs...)
+	closing, err := extract.NewDictionary([]extract.DictionaryEntry{
+		{Value: model.JobStatusClosed, Keywords: config.ClosingPhrases},
+	})
 	if err != nil {
-		return fmt.Errorf("%s: выполнение запроса обновления счётчиков: %w", op, err)
+		logger.Warn("Некорректные фразы о закрытии вакансии", zap.Error(err))
 	}
 
-	// Сбрасываем count у технологий, которые отсутствуют в jobs_raw
-	resetQuery := `
+	return &repository{
+		db:              db,
+		logger:          logger,
+		config:          config,
+		seniority:       seniority,
+		employmentTypes: employmentTypes,
+		closing:         closing,
+	}
+}
+
+func (r *repository) UpdateTechnologiesCount(ctx context.Context) error {
+	op := "repository.jobs.UpdateTechnologiesCount"
+
+	// Считаем все технологии, найденные в активных вакансиях, а не только основные.
+	// Технологии без вакансий получают count = 0
+	query := `
 		UPDATE technologies t
-		SET count = 0
-		WHERE NOT EXISTS (
-			SELECT 1 
-			FROM jobs_raw j 
-			WHERE j.main_technology = t.technology 
-			AND j.main_technology IS NOT NULL 
-			AND j.main_technology != ''
+		SET count = (
+			SELECT COUNT(*)
+			FROM job_technologies jt
+			JOIN jobs_raw j ON j.id = jt.job_id
+			WHERE jt.technology_id = t.id AND j.status = $1
 		)
 	`
 
-	// Выполняем сброс
-	_, err = r.db.Exec(r.context, resetQuery)
-	if err != nil {
-		return fmt.Errorf("%s: выполнение запроса сброса счётчиков: %w", op, err)
+	if _, err := r.db.Exec(ctx, query, model.JobStatusActive); err != nil {
+		return fmt.Errorf("%s: выполнение запроса обновления счётчиков: %w", op, err)
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package jobs
 
 import (
 	"bufio"
+	"context"
 	"os"
 	"strings"
 
@@ -9,7 +10,7 @@ import (
 )
 
 // SaveChannels загружает теги Telegram каналов из указанного файла в БД
-func (r *repository) SaveChannels(filePath string) (int, error) {
+func (r *repository) SaveChannels(ctx context.Context, filePath string) (int, error) {
 	// Открываем файл для чтения
 	file, err := os.Open(filePath)
 	if err != nil {
@@ -56,7 +57,7 @@ func (r *repository) SaveChannels(filePath string) (int, error) {
 	}
 
 	// Выполняем запрос и получаем количество вставленных записей
-	rows, err := r.db.Query(r.context, query, args...)
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
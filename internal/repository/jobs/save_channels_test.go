@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 func TestSaveChannels(t *testing.T) {
 	// GIVEN: Создаем тестовый логгер
 	logger := zaptest.NewLogger(t)
+	ctx := context.Background()
 
 	t.Run("успешный импорт каналов", func(t *testing.T) {
 		// GIVEN: Создаем временный файл с тестовыми данными
@@ -36,7 +38,7 @@ func TestSaveChannels(t *testing.T) {
 		mockRepo := test.NewMockRepository(logger)
 
 		// WHEN: Вызываем метод импорта каналов
-		count, err := mockRepo.SaveChannels(tmpFile.Name())
+		count, err := mockRepo.SaveChannels(ctx, tmpFile.Name())
 
 		// THEN: Проверяем результаты
 		assert.NoError(t, err)
@@ -50,7 +52,7 @@ func TestSaveChannels(t *testing.T) {
 		mockRepo.ShouldError = true
 
 		// WHEN: Вызываем метод импорта каналов
-		count, err := mockRepo.SaveChannels("nonexistent_file.txt")
+		count, err := mockRepo.SaveChannels(ctx, "nonexistent_file.txt")
 
 		// THEN: Проверяем обработку ошибки
 		assert.Error(t, err)
@@ -68,7 +70,7 @@ func TestSaveChannels(t *testing.T) {
 		mockRepo := test.NewMockRepository(logger)
 
 		// WHEN: Вызываем метод импорта каналов
-		count, err := mockRepo.SaveChannels(tmpFile.Name())
+		count, err := mockRepo.SaveChannels(ctx, tmpFile.Name())
 
 		// THEN: Проверяем обработку пустого файла (мок все равно вернет успех,
 		// так как реальная логика не выполняется)
@@ -96,7 +98,7 @@ https://t.me/invalid_url # полная ссылка вместо имени
 		mockRepo := test.NewMockRepository(logger)
 
 		// WHEN: Вызываем метод импорта каналов
-		count, err := mockRepo.SaveChannels(tmpFile.Name())
+		count, err := mockRepo.SaveChannels(ctx, tmpFile.Name())
 
 		// THEN: Проверяем обработку некорректного файла (мок все равно вернет успех,
 		// так как реальная логика валидации не выполняется)
@@ -1,13 +1,16 @@
 package jobs
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"regexp"
-	"strconv"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 	"github.com/lib/pq"
 	"github.com/zalhonan/remotejobs-web-scraper/internal/utils"
 	"github.com/zalhonan/remotejobs-web-scraper/model"
@@ -16,52 +19,46 @@ import (
 
 var channelTagRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
 
-// detectMainTechnology определяет основную технологию вакансии на основе ключевых слов
-// Если в тексте встречается хотя бы одно стоп-слово, функция возвращает пустую строку
-func (r *repository) detectMainTechnology(content string, technologies []model.Technology, stopWords []model.StopWord) string {
-	// Преобразуем контент в нижний регистр для регистронезависимого поиска
-	contentLower := strings.ToLower(content)
-
-	// Проверяем наличие стоп-слов
-	for _, stopWord := range stopWords {
-		if strings.Contains(contentLower, strings.ToLower(stopWord.Word)) {
-			return "" // Если найдено хотя бы одно стоп-слово, возвращаем пустую строку
-		}
-	}
-
-	// Для каждой технологии проверяем наличие ключевых слов
-	for _, tech := range technologies {
-		for _, keyword := range tech.Keywords {
-			if strings.Contains(contentLower, strings.ToLower(keyword)) {
-				return tech.Technology
-			}
-		}
-	}
-
-	return ""
-}
-
-func (r *repository) SaveJobs(jobs []model.JobRaw) (int, error) {
+// SaveJobs сохраняет вакансии и обновляет прогресс их каналов.
+// incomplete - каналы, посты которых собраны не подряд до last_post_id (обход прерван ошибкой,
+// отменой или ограничением глубины): их last_post_id сдвигается, только если собранные посты
+// продолжают сохраненные без пропуска, иначе следующий запуск не дошел бы до пропущенных постов.
+// Возвращает счетчики обработанных постов - итоговые и по каналам
+func (r *repository) SaveJobs(ctx context.Context, jobs []model.JobRaw, incomplete []string) (model.SaveReport, error) {
 	op := "repository.jobs.SaveJobs"
 
+	report := model.SaveReport{Channels: make(map[string]model.PostCounts)}
+
 	if len(jobs) == 0 {
-		return 0, nil
+		return report, nil
 	}
 
+	report.Seen = len(jobs)
+
 	// Получаем список технологий, отсортированный по приоритету
-	technologies, err := r.GetTechnologies()
+	technologies, err := r.GetTechnologies(ctx)
 	if err != nil {
 		r.logger.Warn("Не удалось получить список технологий, вакансии будут сохранены без определения технологии",
 			zap.Error(err))
 	}
 
 	// Получаем список стоп-слов
-	stopWords, err := r.GetStopWords()
+	stopWords, err := r.GetStopWords(ctx)
 	if err != nil {
 		r.logger.Warn("Не удалось получить список стоп-слов, проверка на стоп-слова не будет выполнена",
 			zap.Error(err))
 	}
 
+	// Компилируем ключевые слова один раз на весь пакет вакансий
+	classifier := newClassifier(technologies, stopWords, r.logger)
+
+	// Загружаем отпечатки недавних вакансий для поиска репостов из других каналов
+	duplicates, err := r.loadDuplicateIndex(ctx)
+	if err != nil {
+		r.logger.Warn("Не удалось загрузить отпечатки вакансий, дубликаты не будут объединены",
+			zap.Error(err))
+	}
+
 	// Создаем билдер запросов с соответствующим форматом плейсхолдеров
 	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
@@ -72,12 +69,12 @@ func (r *repository) SaveJobs(jobs []model.JobRaw) (int, error) {
 		ToSql()
 
 	if err != nil {
-		return 0, fmt.Errorf("%s: формирование запроса каналов: %w", op, err)
+		return report, fmt.Errorf("%s: формирование запроса каналов: %w", op, err)
 	}
 
-	rows, err := r.db.Query(r.context, channelsQuery, channelsArgs...)
+	rows, err := r.db.Query(ctx, channelsQuery, channelsArgs...)
 	if err != nil {
-		return 0, fmt.Errorf("%s: выполнение запроса каналов: %w", op, err)
+		return report, fmt.Errorf("%s: выполнение запроса каналов: %w", op, err)
 	}
 	defer rows.Close()
 
@@ -88,7 +85,7 @@ func (r *repository) SaveJobs(jobs []model.JobRaw) (int, error) {
 		var lastPostID *int64
 
 		if err := rows.Scan(&tag, &lastPostID); err != nil {
-			return 0, fmt.Errorf("%s: сканирование строки каналов: %w", op, err)
+			return report, fmt.Errorf("%s: сканирование строки каналов: %w", op, err)
 		}
 
 		// Если lastPostID == nil, устанавливаем его в 0
@@ -101,7 +98,7 @@ func (r *repository) SaveJobs(jobs []model.JobRaw) (int, error) {
 	}
 
 	if err := rows.Err(); err != nil {
-		return 0, fmt.Errorf("%s: итерация по результатам каналов: %w", op, err)
+		return report, fmt.Errorf("%s: итерация по результатам каналов: %w", op, err)
 	}
 
 	// Группируем вакансии по каналам
@@ -131,45 +128,44 @@ func (r *repository) SaveJobs(jobs []model.JobRaw) (int, error) {
 			continue
 		}
 
-		// Определяем основную технологию вакансии
-		if len(technologies) > 0 {
-			job.MainTechnology = r.detectMainTechnology(job.Content, technologies, stopWords)
-		}
+		// Извлекаем зарплату, местоположение, контакты и хэштеги
+		r.extractDetails(&job)
 
-		// Определяем стоп-слова, которые встречаются в вакансии
-		var foundStopWords []string
-		contentLower := strings.ToLower(job.Content)
-		for _, stopWord := range stopWords {
-			if strings.Contains(contentLower, strings.ToLower(stopWord.Word)) {
-				foundStopWords = append(foundStopWords, stopWord.Word)
-			}
-		}
-		job.StopWords = foundStopWords
+		// Определяем стоп-слова и технологии вакансии с учетом хэштегов
+		classifier.classify(&job)
 
 		jobsByChannel[tag] = append(jobsByChannel[tag], job)
 	}
 
 	// Для каждого канала сохраняем вакансии и обновляем информацию о канале
-	totalSaved := 0
-
 	for tag, channelJobs := range jobsByChannel {
 		// Получаем текущий last_post_id канала
 		lastPostID := channels[tag]
-		newLastPostID := lastPostID
-		newJobsCount := 0
+		var minPostID, maxPostID int64
+
+		counts := model.PostCounts{Seen: len(channelJobs)}
+		for _, job := range channelJobs {
+			if len(job.StopWords) > 0 {
+				counts.StopWords++
+			}
+		}
+
+		// Посты канала, уже записанные как источники вакансий
+		known, err := r.knownSources(ctx, channelJobs)
+		if err != nil {
+			return report, fmt.Errorf("%s: %w", op, err)
+		}
 
 		// Начинаем транзакцию
-		tx, err := r.db.Begin(r.context)
+		tx, err := r.db.Begin(ctx)
 		if err != nil {
-			return totalSaved, fmt.Errorf("%s: начало транзакции: %w", op, err)
+			return report, fmt.Errorf("%s: начало транзакции: %w", op, err)
 		}
 
 		// Для всех вакансий из канала
 		for _, job := range channelJobs {
 			// Извлекаем ID поста из ссылки
-			postIDStr := strings.Split(job.SourceLink, "/")[len(strings.Split(job.SourceLink, "/"))-1]
-			postID, err := strconv.ParseInt(postIDStr, 10, 64)
-
+			postID, err := utils.PostIDFromLink(job.SourceLink)
 			if err != nil {
 				r.logger.Warn("Не удалось получить ID поста из ссылки",
 					zap.String("link", job.SourceLink),
@@ -177,14 +173,10 @@ func (r *repository) SaveJobs(jobs []model.JobRaw) (int, error) {
 				continue
 			}
 
-			// Пропускаем посты, которые уже были обработаны
-			if postID <= lastPostID {
-				continue
-			}
-
-			// Обновляем наибольший ID поста
-			if postID > newLastPostID {
-				newLastPostID = postID
+			// Обновляем наибольший и наименьший ID поста
+			maxPostID = max(maxPostID, postID)
+			if minPostID == 0 || postID < minPostID {
+				minPostID = postID
 			}
 
 			// Очистка данных от некорректных UTF-8 символов
@@ -194,96 +186,289 @@ func (r *repository) SaveJobs(jobs []model.JobRaw) (int, error) {
 			job.SourceLink = utils.EnsureValidUTF8(job.SourceLink)
 			job.MainTechnology = utils.EnsureValidUTF8(job.MainTechnology)
 
-			// Получаем ID для слага из INSERT
-			var jobID int64
-			insertBuilder := psql.
-				Insert("jobs_raw").
-				Columns("content", "title", "content_pure", "source_link", "main_technology", "slug", "stop_words", "date_posted", "date_parsed")
+			own, isKnown := known[job.SourceLink]
+
+			// Исключенные по формату работы посты не сохраняем, но учитываем в last_post_id.
+			// Уже сохраненная вакансия обновляется и закрывается
+			if r.skipDropped(&job, own) {
+				counts.Dropped++
+				continue
+			}
 
-			// Сначала пытаемся получить ID для слага
-			newRow := insertBuilder.
-				Values(job.Content, job.Title, job.ContentPure, job.SourceLink, job.MainTechnology, "", squirrel.Expr("?::text[]", pq.Array(job.StopWords)), job.DatePosted, job.DateParsed).
-				Suffix("RETURNING id")
+			// Пост уже объединен с вакансией из другого канала
+			if isKnown && !own {
+				continue
+			}
 
-			idQuery, idArgs, err := newRow.ToSql()
+			// Копию уже сохраненной вакансии не сохраняем, а добавляем пост в ее источники.
+			// Повторно собранный пост самой вакансии дубликатом не считается
+			if !isKnown {
+				if canonicalID, ok := duplicates.find(job.SimHash); ok {
+					err := inSavepoint(ctx, tx, func(tx pgx.Tx) error {
+						return insertJobSource(ctx, tx, canonicalID, job)
+					})
+					if err != nil {
+						r.logger.Warn("Ошибка сохранения источника дубликата вакансии",
+							zap.String("link", job.SourceLink),
+							zap.Int64("jobID", canonicalID),
+							zap.Error(err))
+					}
+					counts.Duplicates++
+					continue
+				}
+			}
+
+			// Перед обновлением сохраненного поста сохраняем его прежний текст, если он изменился
+			if own {
+				err := inSavepoint(ctx, tx, func(tx pgx.Tx) error {
+					return insertJobRevision(ctx, tx, tag, postID, job)
+				})
+				if err != nil {
+					r.logger.Warn("Ошибка сохранения прежней версии вакансии",
+						zap.String("link", job.SourceLink),
+						zap.Error(err))
+				}
+			}
+
+			// Каждый запрос выполняется в точке сохранения: его ошибка не прерывает транзакцию
+			// и не отменяет остальные вакансии канала и обновление last_post_id
+			var jobID int64
+			var inserted bool
+			err = inSavepoint(ctx, tx, func(tx pgx.Tx) error {
+				var err error
+				jobID, inserted, err = upsertJob(ctx, tx, tag, postID, job)
+				return err
+			})
+			if errors.Is(err, pgx.ErrNoRows) {
+				// Пост уже сохранен и не изменился
+				continue
+			}
 			if err != nil {
-				r.logger.Warn("Ошибка формирования запроса для получения ID вакансии",
+				r.logger.Warn("Ошибка сохранения вакансии",
 					zap.String("link", job.SourceLink),
 					zap.Error(err))
 				continue
 			}
 
-			// Выполняем запрос и получаем ID
-			err = tx.QueryRow(r.context, idQuery, idArgs...).Scan(&jobID)
+			// Сохраняем все найденные технологии вакансии
+			err = inSavepoint(ctx, tx, func(tx pgx.Tx) error {
+				return replaceJobTechnologies(ctx, tx, jobID, job.Technologies)
+			})
 			if err != nil {
-				r.logger.Warn("Ошибка выполнения запроса для получения ID вакансии",
+				r.logger.Warn("Ошибка сохранения технологий вакансии",
 					zap.String("link", job.SourceLink),
 					zap.Error(err))
-				continue
 			}
 
-			// Генерируем слаг из ID, заголовка и основной технологии
-			job.Slug = utils.GenerateSlug(jobID, job.Title, job.MainTechnology)
-
-			// Обновляем запись с полученным слагом
-			updateQuery, updateArgs, err := psql.
-				Update("jobs_raw").
-				Set("slug", job.Slug).
-				Where(squirrel.Eq{"id": jobID}).
-				ToSql()
-
+			// Сохраняем хэштеги вакансии
+			err = inSavepoint(ctx, tx, func(tx pgx.Tx) error {
+				return replaceJobTags(ctx, tx, jobID, job.Tags)
+			})
 			if err != nil {
-				r.logger.Warn("Ошибка формирования запроса обновления слага вакансии",
+				r.logger.Warn("Ошибка сохранения хэштегов вакансии",
 					zap.String("link", job.SourceLink),
 					zap.Error(err))
+			}
+
+			if !inserted {
+				counts.Updated++
 				continue
 			}
 
-			// Выполняем UPDATE запрос
-			_, err = tx.Exec(r.context, updateQuery, updateArgs...)
+			// Записываем пост как первый источник вакансии
+			err = inSavepoint(ctx, tx, func(tx pgx.Tx) error {
+				return insertJobSource(ctx, tx, jobID, job)
+			})
 			if err != nil {
-				r.logger.Warn("Ошибка выполнения запроса обновления слага вакансии",
+				r.logger.Warn("Ошибка сохранения источника вакансии",
 					zap.String("link", job.SourceLink),
 					zap.Error(err))
-				// Не прерываем выполнение, так как ID уже получен и вакансия добавлена
 			}
+			duplicates.add(jobID, job.SimHash)
+
+			counts.New++
+		}
+
+		if counts.Dropped > 0 {
+			r.logger.Info("Вакансии исключены по формату работы",
+				zap.String("channel", tag),
+				zap.Int("count", counts.Dropped))
+		}
 
-			newJobsCount++
+		if counts.Updated > 0 {
+			r.logger.Info("Измененные посты обновлены",
+				zap.String("channel", tag),
+				zap.Int("count", counts.Updated))
 		}
 
-		// Если были добавлены новые вакансии, обновляем информацию о канале
-		if newJobsCount > 0 {
+		if counts.Duplicates > 0 {
+			r.logger.Info("Дубликаты вакансий объединены с ранее сохраненными",
+				zap.String("channel", tag),
+				zap.Int("count", counts.Duplicates))
+		}
+
+		newLastPostID := nextLastPostID(lastPostID, minPostID, maxPostID, slices.Contains(incomplete, tag))
+		if newLastPostID < maxPostID {
+			r.logger.Warn("Обход канала неполный, между last_post_id и собранными постами пропуск - last_post_id не сдвигается",
+				zap.String("channel", tag),
+				zap.Int64("last_post_id", lastPostID),
+				zap.Int64("min_post_id", minPostID))
+		}
+
+		// Если были обработаны новые посты, обновляем информацию о канале.
+		// Повторный сбор старых постов не уменьшает last_post_id
+		if counts.New > 0 || newLastPostID > lastPostID {
 			now := time.Now()
 
 			// Формируем UPDATE запрос для канала
 			updateQuery, updateArgs, err := psql.
 				Update("telegram_channels").
 				Set("last_post_id", newLastPostID).
-				Set("posts_parsed", squirrel.Expr("posts_parsed + ?", newJobsCount)).
+				Set("posts_parsed", squirrel.Expr("posts_parsed + ?", counts.New)).
 				Set("date_last_parsed", now).
 				Where(squirrel.Eq{"tag": tag}).
 				ToSql()
 
 			if err != nil {
-				tx.Rollback(r.context)
-				return totalSaved, fmt.Errorf("%s: формирование запроса обновления канала: %w", op, err)
+				tx.Rollback(ctx)
+				return report, fmt.Errorf("%s: формирование запроса обновления канала: %w", op, err)
 			}
 
 			// Выполняем UPDATE запрос
-			_, err = tx.Exec(r.context, updateQuery, updateArgs...)
+			_, err = tx.Exec(ctx, updateQuery, updateArgs...)
 			if err != nil {
-				tx.Rollback(r.context)
-				return totalSaved, fmt.Errorf("%s: выполнение запроса обновления канала: %w", op, err)
+				tx.Rollback(ctx)
+				return report, fmt.Errorf("%s: выполнение запроса обновления канала: %w", op, err)
 			}
 
-			totalSaved += newJobsCount
 		}
 
 		// Фиксируем транзакцию
-		if err := tx.Commit(r.context); err != nil {
-			return totalSaved, fmt.Errorf("%s: завершение транзакции: %w", op, err)
+		if err := tx.Commit(ctx); err != nil {
+			return report, fmt.Errorf("%s: завершение транзакции: %w", op, err)
 		}
+
+		report.Channels[tag] = counts
+		report.New += counts.New
+		report.Updated += counts.Updated
+		report.Duplicates += counts.Duplicates
+		report.Dropped += counts.Dropped
+		report.StopWords += counts.StopWords
+	}
+
+	return report, nil
+}
+
+// nextLastPostID возвращает last_post_id канала после сохранения постов с ID от minPostID до maxPostID.
+// Повторный сбор старых постов его не уменьшает. Если обход канала неполный и собранные посты
+// не продолжают сохраненные без пропуска, last_post_id остается прежним: иначе следующий запуск
+// остановится на нем и посты из пропуска не соберет никогда
+func nextLastPostID(lastPostID, minPostID, maxPostID int64, incomplete bool) int64 {
+	if incomplete && minPostID > lastPostID+1 {
+		return lastPostID
+	}
+	return max(lastPostID, maxPostID)
+}
+
+// sourceTelegram - источник вакансий из Telegram-каналов в колонке jobs_raw.source
+const sourceTelegram = "telegram"
+
+// upsertColumns - колонки jobs_raw, которые обновляются при повторном сборе уже сохраненного поста.
+// ID, слаг и даты остаются прежними, чтобы ссылки на вакансию не менялись
+var upsertColumns = slices.Concat([]string{"content", "title", "content_pure", "main_technology", "stop_words"}, detailsColumns)
+
+// upsertJob сохраняет вакансию по ключу (source, channel, post_id): новую вставляет, у сохраненной
+// обновляет текст, извлеченные данные и статус. ID и слаг новой вакансии формируются в том же запросе,
+// поэтому повторный сбор любого диапазона постов безопасен. Возвращает ID вакансии и признак вставки;
+// если пост уже сохранен с тем же текстом и статусом, возвращает pgx.ErrNoRows
+func upsertJob(ctx context.Context, tx pgx.Tx, channel string, postID int64, job model.JobRaw) (int64, bool, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	var dateClosed any
+	if job.Status != model.JobStatusActive {
+		dateClosed = job.DateParsed
+	}
+
+	values := []any{
+		squirrel.Expr("(SELECT id FROM new_id)"),
+		squirrel.Expr("(SELECT id FROM new_id)::text || ?", utils.SlugSuffix(job.Title, job.MainTechnology)),
+		sourceTelegram, channel, postID, job.SourceLink, job.DatePosted, job.DateParsed, job.Status, dateClosed,
+		job.Content, job.Title, job.ContentPure, job.MainTechnology, squirrel.Expr("?::text[]", pq.Array(job.StopWords)),
+	}
+
+	updates := make([]string, len(upsertColumns))
+	for i, column := range upsertColumns {
+		updates[i] = fmt.Sprintf("%s = EXCLUDED.%s", column, column)
+	}
+
+	// Раз пост получен заново, он не удален: статус определяется его текстом и датой публикации.
+	// Статусы, назначенные вручную, повторный сбор не меняет
+	status := fmt.Sprintf("CASE WHEN jobs_raw.status IN ('%s') THEN jobs_raw.status ELSE EXCLUDED.status END",
+		strings.Join(model.ModerationStatuses, "', '"))
+
+	updates = append(updates,
+		"status = "+status,
+		"date_closed = CASE WHEN "+status+" = 'active' THEN NULL ELSE COALESCE(jobs_raw.date_closed, EXCLUDED.date_closed) END",
+		"date_edited = CASE WHEN jobs_raw.content IS DISTINCT FROM EXCLUDED.content THEN EXCLUDED.date_parsed ELSE jobs_raw.date_edited END",
+	)
+
+	query, args, err := psql.
+		Insert("jobs_raw").
+		Prefix("WITH new_id AS (SELECT nextval(pg_get_serial_sequence('jobs_raw', 'id')) AS id)").
+		Columns("id", "slug", "source", "channel", "post_id", "source_link", "date_posted", "date_parsed", "status", "date_closed").
+		Columns(upsertColumns...).
+		Values(append(values, detailsValues(job)...)...).
+		Suffix("ON CONFLICT (source, channel, post_id) DO UPDATE SET " + strings.Join(updates, ", ") +
+			" WHERE jobs_raw.content IS DISTINCT FROM EXCLUDED.content OR jobs_raw.status IS DISTINCT FROM " + status +
+			" RETURNING id, xmax = 0").
+		ToSql()
+	if err != nil {
+		return 0, false, fmt.Errorf("формирование запроса сохранения вакансии: %w", err)
+	}
+
+	var jobID int64
+	var inserted bool
+	if err := tx.QueryRow(ctx, query, args...).Scan(&jobID, &inserted); err != nil {
+		return 0, false, err
+	}
+
+	return jobID, inserted, nil
+}
+
+// knownSources возвращает посты из jobs, уже записанные в job_sources: ссылка -> true,
+// если это пост самой вакансии, и false, если пост объединен с вакансией как дубликат
+func (r *repository) knownSources(ctx context.Context, jobs []model.JobRaw) (map[string]bool, error) {
+	links := make([]string, len(jobs))
+	for i, job := range jobs {
+		links[i] = job.SourceLink
+	}
+
+	query := `
+		SELECT s.source_link, s.source_link = j.source_link
+		FROM job_sources s
+		JOIN jobs_raw j ON j.id = s.job_id
+		WHERE s.source_link = ANY($1)
+	`
+
+	rows, err := r.db.Query(ctx, query, pq.Array(links))
+	if err != nil {
+		return nil, fmt.Errorf("выполнение запроса источников вакансий: %w", err)
+	}
+	defer rows.Close()
+
+	known := make(map[string]bool)
+	for rows.Next() {
+		var link string
+		var own bool
+		if err := rows.Scan(&link, &own); err != nil {
+			return nil, fmt.Errorf("сканирование источников вакансий: %w", err)
+		}
+		known[link] = own
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("итерация по источникам вакансий: %w", err)
 	}
 
-	return totalSaved, nil
+	return known, nil
 }
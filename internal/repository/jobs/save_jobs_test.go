@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,6 +14,7 @@ import (
 func TestSaveJobs(t *testing.T) {
 	// GIVEN: Создаем тестовый логгер
 	logger := zaptest.NewLogger(t)
+	ctx := context.Background()
 
 	t.Run("успешное сохранение вакансий", func(t *testing.T) {
 		// GIVEN: Мокируем репозиторий и готовим тестовые данные
@@ -26,11 +28,12 @@ func TestSaveJobs(t *testing.T) {
 		}
 
 		// WHEN: Вызываем метод сохранения вакансий
-		count, err := mockRepo.SaveJobs(jobs)
+		report, err := mockRepo.SaveJobs(ctx, jobs, nil)
 
 		// THEN: Проверяем результаты
 		assert.NoError(t, err)
-		assert.Equal(t, 3, count)
+		assert.Equal(t, 3, report.New)
+		assert.Equal(t, 3, report.Channels["test_channel"].Seen)
 		assert.Equal(t, 3, mockRepo.SavedJobs)
 	})
 
@@ -40,11 +43,11 @@ func TestSaveJobs(t *testing.T) {
 		jobs := []model.JobRaw{}
 
 		// WHEN: Вызываем метод сохранения пустого списка вакансий
-		count, err := mockRepo.SaveJobs(jobs)
+		report, err := mockRepo.SaveJobs(ctx, jobs, nil)
 
 		// THEN: Проверяем, что метод корректно обрабатывает пустой список
 		assert.NoError(t, err)
-		assert.Equal(t, 0, count)
+		assert.Equal(t, 0, report.New)
 		assert.Equal(t, 0, mockRepo.SavedJobs)
 	})
 
@@ -58,11 +61,11 @@ func TestSaveJobs(t *testing.T) {
 		}
 
 		// WHEN: Вызываем метод сохранения вакансий
-		count, err := mockRepo.SaveJobs(jobs)
+		report, err := mockRepo.SaveJobs(ctx, jobs, nil)
 
 		// THEN: Проверяем, что возникла ошибка
 		assert.Error(t, err)
-		assert.Equal(t, 0, count)
+		assert.Equal(t, 0, report.New)
 	})
 
 	t.Run("detectMainTechnology корректно определяет технологию", func(t *testing.T) {
@@ -79,7 +82,7 @@ func TestSaveJobs(t *testing.T) {
 		job.Content = "Ищем опытного Go-разработчика со знанием golang"
 
 		// WHEN: Вызываем метод получения технологий и определения технологии
-		technologies, _ := mockRepo.GetTechnologies()
+		technologies, _ := mockRepo.GetTechnologies(ctx)
 
 		// THEN: Проверяем, что технология определена правильно
 		mainTechnology := mockRepo.DetectMainTechnology(job.Content, technologies)
@@ -100,7 +103,7 @@ func TestSaveJobs(t *testing.T) {
 		job.Content = "Требуется разработчик javascript со знанием java"
 
 		// WHEN: Вызываем метод получения технологий и определения технологии
-		technologies, _ := mockRepo.GetTechnologies()
+		technologies, _ := mockRepo.GetTechnologies(ctx)
 
 		// THEN: Проверяем, что выбрана технология с наивысшим приоритетом (наименьшим sort_order)
 		mainTechnology := mockRepo.DetectMainTechnology(job.Content, technologies)
@@ -119,10 +122,40 @@ func TestSaveJobs(t *testing.T) {
 		job.Content = "Требуется разработчик C++ со знанием Python"
 
 		// WHEN: Вызываем метод получения технологий и определения технологии
-		technologies, _ := mockRepo.GetTechnologies()
+		technologies, _ := mockRepo.GetTechnologies(ctx)
 
 		// THEN: Проверяем, что при отсутствии совпадений возвращается пустая строка
 		mainTechnology := mockRepo.DetectMainTechnology(job.Content, technologies)
 		assert.Equal(t, "", mainTechnology)
 	})
 }
+
+// TestNextLastPostID проверяет сдвиг last_post_id канала после сохранения постов согласно шаблону GIVEN-WHEN-THEN
+func TestNextLastPostID(t *testing.T) {
+	tests := []struct {
+		name       string
+		lastPostID int64
+		minPostID  int64
+		maxPostID  int64
+		incomplete bool
+		want       int64
+	}{
+		{name: "полный обход сдвигает до наибольшего поста", lastPostID: 12, minPostID: 11, maxPostID: 50, want: 50},
+		{name: "новый канал с ограничением глубины", lastPostID: 0, minPostID: 61, maxPostID: 100, want: 100},
+		{name: "ошибка на второй странице оставляет last_post_id", lastPostID: 12, minPostID: 31, maxPostID: 50, incomplete: true, want: 12},
+		{name: "ошибка на второй странице нового канала", lastPostID: 0, minPostID: 31, maxPostID: 50, incomplete: true, want: 0},
+		{name: "неполный обход без пропуска", lastPostID: 30, minPostID: 31, maxPostID: 50, incomplete: true, want: 50},
+		{name: "повторный сбор старых постов не уменьшает", lastPostID: 50, minPostID: 1, maxPostID: 20, want: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// GIVEN: Сохраненный last_post_id и диапазон собранных постов
+			// WHEN: Вычисляем новый last_post_id
+			got := nextLastPostID(tt.lastPostID, tt.minPostID, tt.maxPostID, tt.incomplete)
+
+			// THEN: last_post_id не перескакивает через несобранные посты
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// postCountsColumns - колонки счетчиков постов scrape_runs и scrape_run_channels в порядке postCountsValues
+var postCountsColumns = []string{"posts_seen", "posts_new", "posts_updated", "posts_duplicate", "posts_dropped", "posts_stop_words"}
+
+// postCountsValues возвращает значения колонок postCountsColumns
+func postCountsValues(counts model.PostCounts) []any {
+	return []any{counts.Seen, counts.New, counts.Updated, counts.Duplicates, counts.Dropped, counts.StopWords}
+}
+
+// SaveScrapeRun сохраняет отчет о запуске парсера вместе с результатами по каналам и записывает его ID в report.ID
+func (r *repository) SaveScrapeRun(ctx context.Context, report *model.RunReport) error {
+	op := "repository.jobs.SaveScrapeRun"
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	runQuery, runArgs, err := psql.
+		Insert("scrape_runs").
+		Columns("parser", "started_at", "finished_at", "duration_ms", "channels_total", "channels_failed", "error").
+		Columns(postCountsColumns...).
+		Values(slices.Concat([]any{
+			report.Parser, report.StartedAt, report.FinishedAt, report.Duration().Milliseconds(),
+			len(report.Channels), report.ChannelsFailed, nullIfZero(report.Error),
+		}, postCountsValues(report.PostCounts))...).
+		Suffix("RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%s: формирование запроса запуска: %w", op, err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: начало транзакции: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var runID int64
+	if err := tx.QueryRow(ctx, runQuery, runArgs...).Scan(&runID); err != nil {
+		return fmt.Errorf("%s: сохранение запуска: %w", op, err)
+	}
+
+	if len(report.Channels) > 0 {
+		channelsBuilder := psql.
+			Insert("scrape_run_channels").
+			Columns("run_id", "channel", "started_at", "finished_at", "duration_ms", "http_status", "error", "interrupted").
+			Columns(postCountsColumns...)
+
+		for _, channel := range report.Channels {
+			channelsBuilder = channelsBuilder.Values(slices.Concat([]any{
+				runID, channel.Channel, channel.StartedAt, channel.FinishedAt, channel.Duration().Milliseconds(),
+				nullIfZero(channel.HTTPStatus), nullIfZero(channel.Error), channel.Interrupted,
+			}, postCountsValues(channel.PostCounts))...)
+		}
+
+		channelsQuery, channelsArgs, err := channelsBuilder.ToSql()
+		if err != nil {
+			return fmt.Errorf("%s: формирование запроса каналов: %w", op, err)
+		}
+
+		if _, err := tx.Exec(ctx, channelsQuery, channelsArgs...); err != nil {
+			return fmt.Errorf("%s: сохранение каналов запуска: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: завершение транзакции: %w", op, err)
+	}
+
+	report.ID = runID
+
+	return nil
+}
@@ -2,6 +2,7 @@ package jobs
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -11,7 +12,7 @@ import (
 )
 
 // SaveStopWords загружает стоп-слова из указанного файла в БД
-func (r *repository) SaveStopWords(filePath string) (int, error) {
+func (r *repository) SaveStopWords(ctx context.Context, filePath string) (int, error) {
 	op := "repository.jobs.SaveStopWords"
 
 	// Открываем файл для чтения
@@ -60,7 +61,7 @@ func (r *repository) SaveStopWords(filePath string) (int, error) {
 	}
 
 	// Выполняем запрос и получаем количество вставленных записей
-	rows, err := r.db.Query(r.context, query, args...)
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return 0, fmt.Errorf("%s: выполнение запроса: %w", op, err)
 	}
@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 
 func TestSaveStopWords(t *testing.T) {
 	logger := zaptest.NewLogger(t, zaptest.Level(zap.InfoLevel))
+	ctx := context.Background()
 
 	t.Run("успешное сохранение стоп-слов", func(t *testing.T) {
 		// Создаем временный файл для теста
@@ -32,7 +34,7 @@ func TestSaveStopWords(t *testing.T) {
 		mockRepo := test.NewMockRepository(logger)
 
 		// Вызываем метод сохранения стоп-слов
-		count, err := mockRepo.SaveStopWords(tempFile.Name())
+		count, err := mockRepo.SaveStopWords(ctx, tempFile.Name())
 
 		// Проверяем результаты
 		assert.NoError(t, err)
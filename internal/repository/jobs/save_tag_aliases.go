@@ -0,0 +1,90 @@
+package jobs
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// SaveTagAliases загружает связи хэштегов с технологиями из CSV файла в tag_aliases.
+// Формат строки: tag,technology; хэштег указывается без "#", технология - по названию из technologies.
+// Связи с неизвестными технологиями пропускаются
+func (r *repository) SaveTagAliases(ctx context.Context, filePath string) (int, error) {
+	op := "repository.jobs.SaveTagAliases"
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return 0, fmt.Errorf("%s: открытие файла: %w", op, err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return 0, fmt.Errorf("%s: чтение CSV: %w", op, err)
+	}
+
+	if len(rows) <= 1 {
+		// Файл только с заголовками или пустой
+		return 0, nil
+	}
+
+	// Повторный хэштег в файле переопределяет предыдущий, иначе ON CONFLICT обновит строку дважды
+	aliases := make(map[string]string)
+	var tags []string
+	for _, row := range rows[1:] {
+		if len(row) < 2 {
+			continue
+		}
+
+		tag := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(row[0]), "#"))
+		technology := strings.TrimSpace(row[1])
+		if tag == "" || technology == "" {
+			continue
+		}
+
+		if _, exists := aliases[tag]; !exists {
+			tags = append(tags, tag)
+		}
+		aliases[tag] = technology
+	}
+
+	if len(tags) == 0 {
+		return 0, nil
+	}
+
+	technologies := make([]string, len(tags))
+	for i, tag := range tags {
+		technologies[i] = aliases[tag]
+	}
+
+	query := `
+		INSERT INTO tag_aliases (tag, technology_id)
+		SELECT a.tag, t.id
+		FROM unnest($1::text[], $2::text[]) AS a(tag, technology)
+		JOIN technologies t ON t.technology = a.technology
+		ON CONFLICT (tag) DO UPDATE SET technology_id = EXCLUDED.technology_id
+	`
+
+	result, err := r.db.Exec(ctx, query, pq.Array(tags), pq.Array(technologies))
+	if err != nil {
+		return 0, fmt.Errorf("%s: выполнение SQL запроса: %w", op, err)
+	}
+
+	count := int(result.RowsAffected())
+	if count < len(tags) {
+		r.logger.Warn("Часть хэштегов связана с неизвестными технологиями и пропущена",
+			zap.Int("total", len(tags)),
+			zap.Int("saved", count))
+	}
+
+	return count, nil
+}
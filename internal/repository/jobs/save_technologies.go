@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"context"
 	"encoding/csv"
 	"fmt"
 	"os"
@@ -8,11 +9,13 @@ import (
 	"strings"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/extract"
 	"go.uber.org/zap"
 )
 
-// SaveTechnologies загружает технологии из CSV файла в БД
-func (r *repository) SaveTechnologies(filePath string) (int, error) {
+// SaveTechnologies загружает технологии из CSV файла в БД.
+// Формат строки: technology,sort_order,keyword1,keyword2,...; синтаксис ключевых слов описан в пакете extract
+func (r *repository) SaveTechnologies(ctx context.Context, filePath string) (int, error) {
 	op := "repository.jobs.SaveTechnologies"
 
 	// Открываем CSV файл
@@ -82,9 +85,19 @@ func (r *repository) SaveTechnologies(filePath string) (int, error) {
 		var keywords []string
 		for i := 2; i < len(row); i++ {
 			keyword := strings.TrimSpace(row[i])
-			if keyword != "" {
-				keywords = append(keywords, keyword)
+			if keyword == "" {
+				continue
 			}
+
+			// Некорректные регулярные выражения отбрасываем при импорте
+			if _, err := extract.CompileKeyword(keyword); err != nil {
+				r.logger.Warn("Некорректное ключевое слово пропущено",
+					zap.String("technology", technology),
+					zap.Error(err))
+				continue
+			}
+
+			keywords = append(keywords, keyword)
 		}
 
 		// Если нет ключевых слов, используем название технологии
@@ -130,7 +143,7 @@ func (r *repository) SaveTechnologies(filePath string) (int, error) {
 	}
 
 	// Выполняем запрос
-	_, err = r.db.Exec(r.context, query, args...)
+	_, err = r.db.Exec(ctx, query, args...)
 	if err != nil {
 		return 0, fmt.Errorf("%s: выполнение SQL запроса: %w", op, err)
 	}
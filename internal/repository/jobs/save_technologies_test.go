@@ -1,6 +1,7 @@
 package jobs
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -14,6 +15,7 @@ import (
 func TestSaveTechnologies(t *testing.T) {
 	// GIVEN: Создаем тестовый логгер
 	logger := zaptest.NewLogger(t)
+	ctx := context.Background()
 
 	// Создаем временный файл для тестов
 	t.Run("успешный импорт технологий из CSV", func(t *testing.T) {
@@ -36,7 +38,7 @@ func TestSaveTechnologies(t *testing.T) {
 		mockRepo := test.NewMockRepository(logger)
 
 		// WHEN: Вызываем метод импорта технологий
-		count, err := mockRepo.SaveTechnologies(tmpFile.Name())
+		count, err := mockRepo.SaveTechnologies(ctx, tmpFile.Name())
 
 		// THEN: Проверяем результаты импорта
 		assert.NoError(t, err)
@@ -50,7 +52,7 @@ func TestSaveTechnologies(t *testing.T) {
 		mockRepo.ShouldError = true
 
 		// WHEN: Вызываем метод импорта технологий с несуществующим файлом
-		count, err := mockRepo.SaveTechnologies("nonexistent_file.csv")
+		count, err := mockRepo.SaveTechnologies(ctx, "nonexistent_file.csv")
 
 		// THEN: Проверяем обработку ошибки
 		assert.Error(t, err)
@@ -77,7 +79,7 @@ javascript
 		mockRepo := test.NewMockRepository(logger)
 
 		// WHEN: Вызываем метод импорта технологий
-		count, err := mockRepo.SaveTechnologies(tmpFile.Name())
+		count, err := mockRepo.SaveTechnologies(ctx, tmpFile.Name())
 
 		// THEN: Проверяем обработку некорректного файла (мок все равно вернет успех,
 		// так как реальная логика валидации не выполняется)
@@ -96,7 +98,7 @@ javascript
 		mockRepo := test.NewMockRepository(logger)
 
 		// WHEN: Вызываем метод импорта технологий
-		count, err := mockRepo.SaveTechnologies(tmpFile.Name())
+		count, err := mockRepo.SaveTechnologies(ctx, tmpFile.Name())
 
 		// THEN: Проверяем обработку пустого файла (мок все равно вернет успех,
 		// так как реальная логика не выполняется)
@@ -0,0 +1,24 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// inSavepoint выполняет fn в точке сохранения внутри транзакции tx. После ошибки любого запроса
+// PostgreSQL отклоняет все последующие запросы транзакции, поэтому при ошибке fn откатывается
+// только до точки сохранения, и транзакция остается пригодной для следующих запросов
+func inSavepoint(ctx context.Context, tx pgx.Tx, fn func(tx pgx.Tx) error) error {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(savepoint); err != nil {
+		savepoint.Rollback(ctx)
+		return err
+	}
+
+	return savepoint.Commit(ctx)
+}
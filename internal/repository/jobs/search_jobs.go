@@ -0,0 +1,114 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// headlineOptions - параметры ts_headline: выделение найденных слов маркерами model.Highlight*
+// и до двух фрагментов текста вокруг них
+var headlineOptions = fmt.Sprintf(
+	`StartSel="%s", StopSel="%s", MaxFragments=2, MinWords=10, MaxWords=30, FragmentDelimiter=" … "`,
+	model.HighlightStart, model.HighlightEnd,
+)
+
+// titleHeadlineOptions - параметры ts_headline для заголовка: заголовок выводится целиком
+var titleHeadlineOptions = fmt.Sprintf(
+	`StartSel="%s", StopSel="%s", HighlightAll=true`,
+	model.HighlightStart, model.HighlightEnd,
+)
+
+// SearchJobs ищет вакансии полнотекстовым поиском по заголовку и тексту (filter.Query, синтаксис
+// websearch_to_tsquery) с учетом остальных условий фильтра. Результаты упорядочены по релевантности,
+// совпадения в заголовке весят больше; страница задается offset и filter.Limit, курсор не используется.
+// Фрагменты текста с выделенными словами строятся только для вакансий страницы
+func (r *repository) SearchJobs(ctx context.Context, filter model.JobFilter, offset int) (model.SearchPage, error) {
+	op := "repository.jobs.SearchJobs"
+
+	text := strings.TrimSpace(filter.Query)
+	if text == "" {
+		return model.SearchPage{}, fmt.Errorf("%s: %w: пустой поисковый запрос", op, model.ErrInvalidInput)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+
+	// Вложенный запрос собирается с плейсхолдерами "?", внешний переводит их в формат PostgreSQL
+	matches := filterJobs(squirrel.
+		Select("j.*", "ts_rank_cd(j.search_vector, q.query) AS search_rank", "COUNT(*) OVER () AS search_total", "q.query").
+		Prefix("WITH q AS (SELECT "+searchQueryExpr+" AS query)", text, text).
+		From("jobs_raw j").
+		CrossJoin("q").
+		Where("j.search_vector @@ q.query"), filter).
+		OrderBy("search_rank DESC", "j.date_posted DESC", "j.id DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(max(offset, 0)))
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := psql.
+		Select(slices.Concat(jobColumns, []string{"search_rank", "search_total"})...).
+		Column("ts_headline('russian', COALESCE(title, ''), query, ?)", titleHeadlineOptions).
+		Column("ts_headline('russian', COALESCE(content_pure, ''), query, ?)", headlineOptions).
+		FromSelect(matches, "r").
+		OrderBy("search_rank DESC", "date_posted DESC", "id DESC").
+		ToSql()
+
+	if err != nil {
+		return model.SearchPage{}, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.SearchPage{}, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+	defer rows.Close()
+
+	var page model.SearchPage
+	var jobs []model.JobRaw
+
+	for rows.Next() {
+		var result model.SearchResult
+
+		// Колонки вакансии сканирует scanJob, остальные - в том же вызове Scan
+		scanner := &extraScanner{row: rows, extra: []any{&result.Rank, &page.Total, &result.TitleHighlight, &result.Snippet}}
+		if result.Job, err = scanJob(scanner); err != nil {
+			return model.SearchPage{}, fmt.Errorf("%s: сканирование строки: %w", op, err)
+		}
+
+		page.Results = append(page.Results, result)
+		jobs = append(jobs, result.Job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return model.SearchPage{}, fmt.Errorf("%s: итерация по результатам: %w", op, err)
+	}
+	rows.Close()
+
+	if err := r.loadJobRelations(ctx, jobs); err != nil {
+		return model.SearchPage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i := range page.Results {
+		page.Results[i].Job = jobs[i]
+	}
+
+	return page, nil
+}
+
+// extraScanner дополняет сканирование строки колонками, идущими после колонок jobColumns
+type extraScanner struct {
+	row   interface{ Scan(dest ...any) error }
+	extra []any
+}
+
+func (s *extraScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(dest, s.extra...)...)
+}
@@ -0,0 +1,22 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSearchJobsEmptyQuery(t *testing.T) {
+	// GIVEN: Репозиторий без подключения к БД
+	repo := NewRepository(nil, zaptest.NewLogger(t), DefaultConfig())
+
+	// WHEN: Ищем по запросу из пробелов
+	page, err := repo.SearchJobs(context.Background(), model.JobFilter{Query: "  "}, 0)
+
+	// THEN: Запрос отклонен до обращения к БД
+	assert.ErrorIs(t, err, model.ErrInvalidInput)
+	assert.Empty(t, page.Results)
+}
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// SetJobStatus вручную меняет статус вакансии, например скрывает ее или помечает спамом.
+// Возвращает false, если вакансии с таким ID нет
+func (r *repository) SetJobStatus(ctx context.Context, id int64, status string) (bool, error) {
+	op := "repository.jobs.SetJobStatus"
+
+	if !slices.Contains(model.JobStatuses, status) {
+		return false, fmt.Errorf("%s: %w: неизвестный статус %q", op, model.ErrInvalidInput, status)
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+	dateClosed := squirrel.Expr("COALESCE(date_closed, NOW())")
+	if status == model.JobStatusActive {
+		dateClosed = squirrel.Expr("NULL")
+	}
+
+	query, args, err := psql.
+		Update("jobs_raw").
+		Set("status", status).
+		Set("date_closed", dateClosed).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+
+	if err != nil {
+		return false, fmt.Errorf("%s: формирование SQL-запроса: %w", op, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("%s: выполнение запроса: %w", op, err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
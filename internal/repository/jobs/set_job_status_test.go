@@ -0,0 +1,22 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSetJobStatusUnknown(t *testing.T) {
+	// GIVEN: Репозиторий без подключения к БД
+	repo := NewRepository(nil, zaptest.NewLogger(t), DefaultConfig())
+
+	// WHEN: Устанавливаем неизвестный статус
+	found, err := repo.SetJobStatus(context.Background(), 1, "archived")
+
+	// THEN: Статус отклонен до обращения к БД
+	assert.ErrorIs(t, err, model.ErrInvalidInput)
+	assert.False(t, found)
+}
@@ -1,16 +1,43 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/zalhonan/remotejobs-web-scraper/model"
 )
 
 type JobsRepository interface {
-	GetTelegramChannels() ([]model.TelegramChannel, error)
-	SaveJobs(jobs []model.JobRaw) (int, error)
-	SaveChannels(jobsList string) (int, error)
-	SaveTechnologies(technologiesFile string) (int, error)
-	SaveStopWords(stopWordsFile string) (int, error)
-	GetTechnologies() ([]model.Technology, error)
-	GetStopWords() ([]model.StopWord, error)
-	UpdateTechnologiesCount() error
+	GetTelegramChannels(ctx context.Context) ([]model.TelegramChannel, error)
+	SaveJobs(ctx context.Context, jobs []model.JobRaw, incomplete []string) (model.SaveReport, error)
+	SaveChannels(ctx context.Context, jobsList string) (int, error)
+	SaveTechnologies(ctx context.Context, technologiesFile string) (int, error)
+	SaveStopWords(ctx context.Context, stopWordsFile string) (int, error)
+	SaveTagAliases(ctx context.Context, tagAliasesFile string) (int, error)
+	IsDataImported(ctx context.Context) (bool, error)
+	SaveDataImport(ctx context.Context) error
+	GetTechnologies(ctx context.Context) ([]model.Technology, error)
+	GetStopWords(ctx context.Context) ([]model.StopWord, error)
+	SaveTechnology(ctx context.Context, tech model.Technology) (bool, error)
+	RemoveTechnology(ctx context.Context, technology string) (bool, error)
+	AddStopWord(ctx context.Context, word string) (bool, error)
+	RemoveStopWord(ctx context.Context, word string) (bool, error)
+	UpdateTechnologiesCount(ctx context.Context) error
+	AddChannel(ctx context.Context, tag string) (bool, error)
+	RemoveChannel(ctx context.Context, tag string) (bool, error)
+	RewindChannel(ctx context.Context, tag string, postID int64) (bool, error)
+	SetChannelEnabled(ctx context.Context, tag string, enabled bool) (bool, error)
+	GetChannelHealth(ctx context.Context) ([]model.ChannelHealth, error)
+	CheckChannelHealth(ctx context.Context) (int, error)
+	ReclassifyJobs(ctx context.Context) (int, error)
+	BackfillJobDetails(ctx context.Context) (int, error)
+	MarkDeletedJobs(ctx context.Context, revisit model.RevisitedChannel) (int, error)
+	ExpireJobs(ctx context.Context) (int, error)
+	SetJobStatus(ctx context.Context, id int64, status string) (bool, error)
+	GetStats(ctx context.Context) (model.Stats, error)
+	GetTags(ctx context.Context, limit int) ([]model.TagCount, error)
+	ListJobs(ctx context.Context, filter model.JobFilter) (model.JobsPage, error)
+	SearchJobs(ctx context.Context, filter model.JobFilter, offset int) (model.SearchPage, error)
+	GetJobBySlug(ctx context.Context, slug string) (model.JobRaw, bool, error)
+	SaveScrapeRun(ctx context.Context, report *model.RunReport) error
+	GetScrapeRuns(ctx context.Context, limit int) ([]model.RunReport, error)
 }
@@ -1,8 +1,11 @@
 package test
 
 import (
+	"cmp"
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,11 +17,23 @@ import (
 type MockRepository struct {
 	TelegramChannels []model.TelegramChannel
 	Technologies     []model.Technology
-	SavedJobs        int
-	SavedChannels    int
-	SavedTechs       int
-	ShouldError      bool
-	Logger           *zap.Logger
+	// StopWords - стоп-слова мока; пока список пуст, GetStopWords возвращает стоп-слова по умолчанию
+	StopWords     []model.StopWord
+	SavedJobs     int
+	SavedChannels int
+	SavedTechs    int
+	// Revisited - каналы, переданные в MarkDeletedJobs
+	Revisited []model.RevisitedChannel
+	// Runs - сохраненные отчеты о запусках
+	Runs []model.RunReport
+	// DataImported - отметка о загрузке файлов данных, которую возвращает IsDataImported
+	DataImported bool
+	// IncompleteChannels - каналы с неполным обходом, переданные в последний вызов SaveJobs
+	IncompleteChannels []string
+	// Jobs - вакансии, которые возвращают ListJobs и GetJobBySlug
+	Jobs        []model.JobRaw
+	ShouldError bool
+	Logger      *zap.Logger
 }
 
 // NewMockRepository создает новый мок-репозиторий для тестирования
@@ -35,24 +50,39 @@ func NewMockRepository(logger *zap.Logger) *MockRepository {
 }
 
 // GetTelegramChannels возвращает моковые каналы Telegram
-func (m *MockRepository) GetTelegramChannels() ([]model.TelegramChannel, error) {
+func (m *MockRepository) GetTelegramChannels(ctx context.Context) ([]model.TelegramChannel, error) {
 	if m.ShouldError {
 		return nil, errors.New("mock error getting telegram channels")
 	}
 	return m.TelegramChannels, nil
 }
 
-// SaveJobs имитирует сохранение вакансий
-func (m *MockRepository) SaveJobs(jobs []model.JobRaw) (int, error) {
+// SaveJobs имитирует сохранение вакансий: все вакансии считаются новыми.
+// Каналы с неполным обходом запоминаются в IncompleteChannels
+func (m *MockRepository) SaveJobs(ctx context.Context, jobs []model.JobRaw, incomplete []string) (model.SaveReport, error) {
 	if m.ShouldError {
-		return 0, errors.New("mock error saving jobs")
+		return model.SaveReport{}, errors.New("mock error saving jobs")
 	}
 	m.SavedJobs = len(jobs)
-	return m.SavedJobs, nil
+	m.IncompleteChannels = incomplete
+
+	report := model.SaveReport{Channels: make(map[string]model.PostCounts)}
+	for _, job := range jobs {
+		channel := ""
+		if parts := strings.Split(job.SourceLink, "/"); len(parts) >= 2 {
+			channel = parts[len(parts)-2]
+		}
+
+		counts := report.Channels[channel]
+		counts.Add(model.PostCounts{Seen: 1, New: 1})
+		report.Channels[channel] = counts
+		report.Add(model.PostCounts{Seen: 1, New: 1})
+	}
+	return report, nil
 }
 
 // SaveChannels имитирует сохранение каналов
-func (m *MockRepository) SaveChannels(channelsFile string) (int, error) {
+func (m *MockRepository) SaveChannels(ctx context.Context, channelsFile string) (int, error) {
 	if m.ShouldError {
 		return 0, errors.New("mock error saving channels")
 	}
@@ -60,8 +90,25 @@ func (m *MockRepository) SaveChannels(channelsFile string) (int, error) {
 	return m.SavedChannels, nil
 }
 
+// IsDataImported возвращает отметку о загрузке файлов данных
+func (m *MockRepository) IsDataImported(ctx context.Context) (bool, error) {
+	if m.ShouldError {
+		return false, errors.New("mock error checking data import")
+	}
+	return m.DataImported, nil
+}
+
+// SaveDataImport отмечает загрузку файлов данных
+func (m *MockRepository) SaveDataImport(ctx context.Context) error {
+	if m.ShouldError {
+		return errors.New("mock error saving data import")
+	}
+	m.DataImported = true
+	return nil
+}
+
 // SaveTechnologies имитирует сохранение технологий
-func (m *MockRepository) SaveTechnologies(technologiesFile string) (int, error) {
+func (m *MockRepository) SaveTechnologies(ctx context.Context, technologiesFile string) (int, error) {
 	if m.ShouldError {
 		return 0, errors.New("mock error saving technologies")
 	}
@@ -70,7 +117,7 @@ func (m *MockRepository) SaveTechnologies(technologiesFile string) (int, error)
 }
 
 // GetTechnologies возвращает моковые технологии
-func (m *MockRepository) GetTechnologies() ([]model.Technology, error) {
+func (m *MockRepository) GetTechnologies(ctx context.Context) ([]model.Technology, error) {
 	if m.ShouldError {
 		return nil, errors.New("mock error getting technologies")
 	}
@@ -85,10 +132,13 @@ func (m *MockRepository) GetTechnologies() ([]model.Technology, error) {
 }
 
 // GetStopWords возвращает моковые стоп-слова
-func (m *MockRepository) GetStopWords() ([]model.StopWord, error) {
+func (m *MockRepository) GetStopWords(ctx context.Context) ([]model.StopWord, error) {
 	if m.ShouldError {
 		return nil, errors.New("mock error getting stop words")
 	}
+	if len(m.StopWords) > 0 {
+		return m.StopWords, nil
+	}
 	return []model.StopWord{
 		{ID: 1, Word: "стремитесь"},
 		{ID: 2, Word: "адвокат"},
@@ -96,19 +146,396 @@ func (m *MockRepository) GetStopWords() ([]model.StopWord, error) {
 	}, nil
 }
 
+// SaveTechnology имитирует добавление или изменение технологии
+func (m *MockRepository) SaveTechnology(ctx context.Context, tech model.Technology) (bool, error) {
+	if m.ShouldError {
+		return false, errors.New("mock error saving technology")
+	}
+	if tech.SortOrder < -100 || tech.SortOrder > 100 {
+		return false, fmt.Errorf("%w: sort_order должен быть от -100 до 100", model.ErrInvalidInput)
+	}
+	for i, existing := range m.Technologies {
+		if existing.Technology == tech.Technology {
+			m.Technologies[i].Keywords = tech.Keywords
+			m.Technologies[i].SortOrder = tech.SortOrder
+			return false, nil
+		}
+	}
+	tech.ID = int64(len(m.Technologies) + 1)
+	m.Technologies = append(m.Technologies, tech)
+	return true, nil
+}
+
+// RemoveTechnology имитирует удаление технологии
+func (m *MockRepository) RemoveTechnology(ctx context.Context, technology string) (bool, error) {
+	if m.ShouldError {
+		return false, errors.New("mock error removing technology")
+	}
+	for i, tech := range m.Technologies {
+		if tech.Technology == technology {
+			m.Technologies = slices.Delete(m.Technologies, i, i+1)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AddStopWord имитирует добавление стоп-слова
+func (m *MockRepository) AddStopWord(ctx context.Context, word string) (bool, error) {
+	if m.ShouldError {
+		return false, errors.New("mock error adding stop word")
+	}
+	if strings.TrimSpace(word) == "" {
+		return false, fmt.Errorf("%w: пустое стоп-слово", model.ErrInvalidInput)
+	}
+	for _, stopWord := range m.StopWords {
+		if stopWord.Word == word {
+			return false, nil
+		}
+	}
+	m.StopWords = append(m.StopWords, model.StopWord{ID: int64(len(m.StopWords) + 1), Word: word})
+	return true, nil
+}
+
+// RemoveStopWord имитирует удаление стоп-слова
+func (m *MockRepository) RemoveStopWord(ctx context.Context, word string) (bool, error) {
+	if m.ShouldError {
+		return false, errors.New("mock error removing stop word")
+	}
+	for i, stopWord := range m.StopWords {
+		if stopWord.Word == word {
+			m.StopWords = slices.Delete(m.StopWords, i, i+1)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // SaveStopWords имитирует сохранение стоп-слов
-func (m *MockRepository) SaveStopWords(stopWordsFile string) (int, error) {
+func (m *MockRepository) SaveStopWords(ctx context.Context, stopWordsFile string) (int, error) {
 	return 3, nil
 }
 
+// SaveTagAliases имитирует сохранение связей хэштегов с технологиями
+func (m *MockRepository) SaveTagAliases(ctx context.Context, tagAliasesFile string) (int, error) {
+	if m.ShouldError {
+		return 0, errors.New("mock error saving tag aliases")
+	}
+	return 2, nil
+}
+
 // UpdateTechnologiesCount имитирует обновление счетчика вакансий для каждой технологии
-func (m *MockRepository) UpdateTechnologiesCount() error {
+func (m *MockRepository) UpdateTechnologiesCount(ctx context.Context) error {
 	if m.ShouldError {
 		return errors.New("mock error updating technologies count")
 	}
 	return nil
 }
 
+// AddChannel имитирует добавление канала
+func (m *MockRepository) AddChannel(ctx context.Context, tag string) (bool, error) {
+	if m.ShouldError {
+		return false, errors.New("mock error adding channel")
+	}
+	if tag == "" || strings.ContainsAny(tag, "@/ ") {
+		return false, fmt.Errorf("%w: некорректный тег канала %q", model.ErrInvalidInput, tag)
+	}
+	for _, channel := range m.TelegramChannels {
+		if channel.Tag == tag {
+			return false, nil
+		}
+	}
+	m.TelegramChannels = append(m.TelegramChannels, model.TelegramChannel{
+		ID:               int64(len(m.TelegramChannels) + 1),
+		Tag:              tag,
+		DateChannelAdded: time.Now(),
+	})
+	return true, nil
+}
+
+// RemoveChannel имитирует удаление канала
+func (m *MockRepository) RemoveChannel(ctx context.Context, tag string) (bool, error) {
+	if m.ShouldError {
+		return false, errors.New("mock error removing channel")
+	}
+	for i, channel := range m.TelegramChannels {
+		if channel.Tag == tag {
+			m.TelegramChannels = append(m.TelegramChannels[:i], m.TelegramChannels[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RewindChannel имитирует перемотку last_post_id канала
+func (m *MockRepository) RewindChannel(ctx context.Context, tag string, postID int64) (bool, error) {
+	if m.ShouldError {
+		return false, errors.New("mock error rewinding channel")
+	}
+	for i, channel := range m.TelegramChannels {
+		if channel.Tag == tag {
+			m.TelegramChannels[i].LastPostID = &postID
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// SetChannelEnabled имитирует ручное включение и отключение канала: отключенный канал удаляется из мока
+func (m *MockRepository) SetChannelEnabled(ctx context.Context, tag string, enabled bool) (bool, error) {
+	if m.ShouldError {
+		return false, errors.New("mock error setting channel enabled")
+	}
+	for i, channel := range m.TelegramChannels {
+		if channel.Tag == tag {
+			if !enabled {
+				m.TelegramChannels = append(m.TelegramChannels[:i], m.TelegramChannels[i+1:]...)
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetChannelHealth возвращает показатели здоровья моковых каналов без проблем
+func (m *MockRepository) GetChannelHealth(ctx context.Context) ([]model.ChannelHealth, error) {
+	if m.ShouldError {
+		return nil, errors.New("mock error getting channel health")
+	}
+	health := make([]model.ChannelHealth, len(m.TelegramChannels))
+	for i, channel := range m.TelegramChannels {
+		health[i] = model.ChannelHealth{Tag: channel.Tag, Enabled: true, LastActive: channel.DateChannelAdded}
+	}
+	return health, nil
+}
+
+// CheckChannelHealth имитирует проверку здоровья каналов
+func (m *MockRepository) CheckChannelHealth(ctx context.Context) (int, error) {
+	if m.ShouldError {
+		return 0, errors.New("mock error checking channel health")
+	}
+	return 0, nil
+}
+
+// ReclassifyJobs имитирует повторную классификацию вакансий
+func (m *MockRepository) ReclassifyJobs(ctx context.Context) (int, error) {
+	if m.ShouldError {
+		return 0, errors.New("mock error reclassifying jobs")
+	}
+	return m.SavedJobs, nil
+}
+
+// BackfillJobDetails имитирует повторное извлечение данных вакансий
+func (m *MockRepository) BackfillJobDetails(ctx context.Context) (int, error) {
+	if m.ShouldError {
+		return 0, errors.New("mock error backfilling job details")
+	}
+	return m.SavedJobs, nil
+}
+
+// MarkDeletedJobs имитирует пометку удаленных вакансий
+func (m *MockRepository) MarkDeletedJobs(ctx context.Context, revisit model.RevisitedChannel) (int, error) {
+	if m.ShouldError {
+		return 0, errors.New("mock error marking deleted jobs")
+	}
+	m.Revisited = append(m.Revisited, revisit)
+	return 0, nil
+}
+
+// ExpireJobs имитирует перевод устаревших вакансий в статус expired
+func (m *MockRepository) ExpireJobs(ctx context.Context) (int, error) {
+	if m.ShouldError {
+		return 0, errors.New("mock error expiring jobs")
+	}
+	return 0, nil
+}
+
+// SetJobStatus имитирует ручную смену статуса вакансии
+func (m *MockRepository) SetJobStatus(ctx context.Context, id int64, status string) (bool, error) {
+	if m.ShouldError {
+		return false, errors.New("mock error setting job status")
+	}
+	return id > 0 && id <= int64(m.SavedJobs), nil
+}
+
+// GetStats возвращает статистику по сохраненным в моке данным
+func (m *MockRepository) GetStats(ctx context.Context) (model.Stats, error) {
+	if m.ShouldError {
+		return model.Stats{}, errors.New("mock error getting stats")
+	}
+	return model.Stats{
+		JobsTotal:     int64(m.SavedJobs),
+		JobsActive:    int64(m.SavedJobs),
+		ChannelsTotal: int64(len(m.TelegramChannels)),
+	}, nil
+}
+
+// GetTags возвращает хэштеги активных вакансий из Jobs по убыванию их количества
+func (m *MockRepository) GetTags(ctx context.Context, limit int) ([]model.TagCount, error) {
+	if m.ShouldError {
+		return nil, errors.New("mock error getting tags")
+	}
+
+	counts := make(map[string]int64)
+	for _, job := range m.Jobs {
+		if job.Status != model.JobStatusActive {
+			continue
+		}
+		for _, tag := range job.Tags {
+			counts[tag]++
+		}
+	}
+
+	tags := []model.TagCount{}
+	for tag, count := range counts {
+		tags = append(tags, model.TagCount{Tag: tag, Count: count})
+	}
+	slices.SortFunc(tags, func(a, b model.TagCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), strings.Compare(a.Tag, b.Tag))
+	})
+	if limit > 0 && len(tags) > limit {
+		tags = tags[:limit]
+	}
+	return tags, nil
+}
+
+// SaveScrapeRun имитирует сохранение отчета о запуске
+func (m *MockRepository) SaveScrapeRun(ctx context.Context, report *model.RunReport) error {
+	if m.ShouldError {
+		return errors.New("mock error saving scrape run")
+	}
+	report.ID = int64(len(m.Runs) + 1)
+	m.Runs = append(m.Runs, *report)
+	return nil
+}
+
+// GetScrapeRuns возвращает сохраненные в моке отчеты о запусках, начиная с самого нового
+func (m *MockRepository) GetScrapeRuns(ctx context.Context, limit int) ([]model.RunReport, error) {
+	if m.ShouldError {
+		return nil, errors.New("mock error getting scrape runs")
+	}
+	runs := slices.Clone(m.Runs)
+	slices.Reverse(runs)
+	if limit > 0 && len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs, nil
+}
+
+// ListJobs возвращает страницу вакансий из Jobs, отсортированных по дате публикации и ID по убыванию
+func (m *MockRepository) ListJobs(ctx context.Context, filter model.JobFilter) (model.JobsPage, error) {
+	if m.ShouldError {
+		return model.JobsPage{}, errors.New("mock error listing jobs")
+	}
+
+	statuses := filter.Statuses
+	if len(statuses) == 0 {
+		statuses = []string{model.JobStatusActive}
+	}
+
+	var jobs []model.JobRaw
+	for _, job := range m.Jobs {
+		switch {
+		case !slices.Contains(statuses, job.Status),
+			filter.Technology != "" && !slices.ContainsFunc(job.Technologies, func(tech model.JobTechnology) bool {
+				return strings.EqualFold(tech.Technology, filter.Technology)
+			}),
+			filter.Tag != "" && !slices.Contains(job.Tags, strings.ToLower(strings.TrimPrefix(filter.Tag, "#"))),
+			filter.Channel != "" && !strings.HasPrefix(job.SourceLink, "https://t.me/"+strings.TrimPrefix(filter.Channel, "@")+"/"),
+			!filter.From.IsZero() && job.DatePosted.Before(filter.From),
+			!filter.To.IsZero() && !job.DatePosted.Before(filter.To),
+			filter.Query != "" && !matchesQuery(job, filter.Query),
+			filter.After != nil && !job.DatePosted.Before(filter.After.DatePosted) &&
+				!(job.DatePosted.Equal(filter.After.DatePosted) && job.ID < filter.After.ID):
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+
+	slices.SortFunc(jobs, func(a, b model.JobRaw) int {
+		if c := b.DatePosted.Compare(a.DatePosted); c != 0 {
+			return c
+		}
+		return int(b.ID - a.ID)
+	})
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	page := model.JobsPage{Jobs: jobs}
+	if len(jobs) > limit {
+		page.Jobs = jobs[:limit]
+		last := page.Jobs[limit-1]
+		page.Next = &model.JobCursor{DatePosted: last.DatePosted, ID: last.ID}
+	}
+	return page, nil
+}
+
+// SearchJobs ищет вакансии из Jobs, содержащие все слова запроса; релевантность - количество вхождений слов
+func (m *MockRepository) SearchJobs(ctx context.Context, filter model.JobFilter, offset int) (model.SearchPage, error) {
+	if m.ShouldError {
+		return model.SearchPage{}, errors.New("mock error searching jobs")
+	}
+
+	query, limit := filter.Query, filter.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	filter.Query, filter.After, filter.Limit = "", nil, len(m.Jobs)
+
+	page, _ := m.ListJobs(ctx, filter)
+
+	var results []model.SearchResult
+	for _, job := range page.Jobs {
+		if !matchesQuery(job, query) {
+			continue
+		}
+
+		result := model.SearchResult{Job: job, TitleHighlight: job.Title, Snippet: job.ContentPure}
+		for _, word := range strings.Fields(strings.ToLower(query)) {
+			result.Rank += float64(strings.Count(strings.ToLower(job.Title+" "+job.ContentPure), word))
+			result.Snippet = strings.ReplaceAll(result.Snippet, word, model.HighlightStart+word+model.HighlightEnd)
+		}
+		results = append(results, result)
+	}
+
+	slices.SortStableFunc(results, func(a, b model.SearchResult) int {
+		return cmp.Compare(b.Rank, a.Rank)
+	})
+
+	search := model.SearchPage{Total: int64(len(results))}
+	if offset < len(results) {
+		search.Results = results[offset:min(offset+limit, len(results))]
+	}
+	return search, nil
+}
+
+// matchesQuery сообщает, что заголовок или текст вакансии содержат все слова запроса
+func matchesQuery(job model.JobRaw, query string) bool {
+	text := strings.ToLower(job.Title + " " + job.ContentPure)
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetJobBySlug возвращает вакансию из Jobs по slug
+func (m *MockRepository) GetJobBySlug(ctx context.Context, slug string) (model.JobRaw, bool, error) {
+	if m.ShouldError {
+		return model.JobRaw{}, false, errors.New("mock error getting job")
+	}
+	for _, job := range m.Jobs {
+		if job.Slug == slug {
+			return job, true, nil
+		}
+	}
+	return model.JobRaw{}, false, nil
+}
+
 // DetectMainTechnology определяет основную технологию вакансии на основе ключевых слов
 func (m *MockRepository) DetectMainTechnology(content string, technologies []model.Technology) string {
 	// Преобразуем контент в нижний регистр для регистронезависимого поиска
@@ -132,6 +559,10 @@ type MockParser struct {
 	ShouldError bool
 	ParserName  string
 	Logger      *zap.Logger
+	// Err возвращается вместе с Jobs для имитации частичного результата
+	Err error
+	// Revisited возвращается из RevisitJobs
+	Revisited []model.RevisitedChannel
 }
 
 // NewMockParser создает новый мок-парсер для тестирования
@@ -145,11 +576,19 @@ func NewMockParser(logger *zap.Logger) *MockParser {
 }
 
 // ParseJobs имитирует парсинг вакансий
-func (p *MockParser) ParseJobs() ([]model.JobRaw, error) {
+func (p *MockParser) ParseJobs(ctx context.Context) ([]model.JobRaw, error) {
 	if p.ShouldError {
 		return nil, errors.New("mock error parsing jobs")
 	}
-	return p.Jobs, nil
+	return p.Jobs, p.Err
+}
+
+// RevisitJobs имитирует повторный обход недавних постов
+func (p *MockParser) RevisitJobs(ctx context.Context) ([]model.RevisitedChannel, error) {
+	if p.ShouldError {
+		return nil, errors.New("mock error revisiting jobs")
+	}
+	return p.Revisited, p.Err
 }
 
 // Name возвращает имя парсера
@@ -167,6 +606,8 @@ func CreateMockJob(id int64, technology string) model.JobRaw {
 		ContentPure:    content,
 		SourceLink:     fmt.Sprintf("https://t.me/test_channel/%d", id),
 		MainTechnology: technology,
+		Slug:           fmt.Sprintf("testovaya-vakansiya-%d", id),
+		Status:         model.JobStatusActive,
 		DatePosted:     time.Now().Add(-24 * time.Hour),
 		DateParsed:     time.Now(),
 	}
@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Schedule вычисляет время следующего запуска задачи
+type Schedule interface {
+	Next(t time.Time) time.Time
+}
+
+// everySchedule запускает задачу через фиксированные промежутки времени
+type everySchedule struct {
+	period time.Duration
+}
+
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Add(s.period)
+}
+
+// Every возвращает расписание с фиксированным периодом
+func Every(period time.Duration) Schedule {
+	return everySchedule{period: period}
+}
+
+// ParseSchedule разбирает расписание: фиксированный период в формате time.Duration ("30m", "1h")
+// либо cron-выражение из пяти полей или дескриптор ("*/15 * * * *", "@hourly", "@every 1h")
+func ParseSchedule(spec string) (Schedule, error) {
+	op := "scheduler.ParseSchedule"
+
+	if period, err := time.ParseDuration(spec); err == nil {
+		if period <= 0 {
+			return nil, fmt.Errorf("%s: период должен быть положительным: %q", op, spec)
+		}
+		return Every(period), nil
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("%s: разбор расписания %q: %w", op, spec, err)
+	}
+
+	return schedule, nil
+}
@@ -0,0 +1,173 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Config задает параметры планировщика и расписания сбора вакансий по источникам
+type Config struct {
+	// Jitter - максимальная случайная задержка, добавляемая к каждому запуску
+	Jitter time.Duration `yaml:"jitter"`
+	// RunOnStart запускает все задачи сразу при старте, не дожидаясь расписания
+	RunOnStart bool `yaml:"run_on_start"`
+	// DefaultSchedule - расписание для источников, не указанных в Schedules
+	DefaultSchedule string `yaml:"default_schedule"`
+	// Schedules - расписания по именам источников в нижнем регистре, например "telegram"
+	Schedules map[string]string `yaml:"schedules"`
+}
+
+// DefaultConfig возвращает конфигурацию планировщика по умолчанию
+func DefaultConfig() Config {
+	return Config{
+		Jitter:          2 * time.Minute,
+		RunOnStart:      true,
+		DefaultSchedule: "1h",
+	}
+}
+
+// ScheduleFor возвращает расписание для источника с указанным именем
+func (c Config) ScheduleFor(name string) string {
+	if spec, ok := c.Schedules[strings.ToLower(name)]; ok && spec != "" {
+		return spec
+	}
+	return c.DefaultSchedule
+}
+
+// job - задача с расписанием и признаком выполнения для защиты от наложения запусков
+type job struct {
+	name     string
+	schedule Schedule
+	run      func(ctx context.Context) error
+	running  atomic.Bool
+}
+
+// Scheduler запускает задачи по расписанию до отмены контекста
+type Scheduler struct {
+	config Config
+	logger *zap.Logger
+	jobs   []*job
+}
+
+func NewScheduler(config Config, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		config: config,
+		logger: logger,
+	}
+}
+
+// Add регистрирует задачу с расписанием в формате ParseSchedule
+func (s *Scheduler) Add(name string, spec string, run func(ctx context.Context) error) error {
+	op := "scheduler.Add"
+
+	schedule, err := ParseSchedule(spec)
+	if err != nil {
+		return fmt.Errorf("%s: задача %s: %w", op, name, err)
+	}
+
+	s.AddSchedule(name, schedule, run)
+	return nil
+}
+
+// AddSchedule регистрирует задачу с готовым расписанием
+func (s *Scheduler) AddSchedule(name string, schedule Schedule, run func(ctx context.Context) error) {
+	s.jobs = append(s.jobs, &job{
+		name:     name,
+		schedule: schedule,
+		run:      run,
+	})
+}
+
+// Run запускает задачи по расписанию и блокируется до отмены ctx.
+// После отмены новые запуски не начинаются, а метод дожидается завершения уже выполняющихся задач
+func (s *Scheduler) Run(ctx context.Context) {
+	var loops sync.WaitGroup
+	var runs sync.WaitGroup
+
+	for _, j := range s.jobs {
+		loops.Add(1)
+		go func() {
+			defer loops.Done()
+			s.loop(ctx, j, &runs)
+		}()
+	}
+
+	loops.Wait()
+	runs.Wait()
+
+	s.logger.Info("Планировщик остановлен")
+}
+
+// loop ожидает очередного времени запуска задачи и стартует ее, если предыдущий запуск завершен
+func (s *Scheduler) loop(ctx context.Context, j *job, runs *sync.WaitGroup) {
+	if s.config.RunOnStart {
+		s.start(ctx, j, runs)
+	}
+
+	for {
+		next := j.schedule.Next(time.Now()).Add(s.jitter())
+
+		s.logger.Info("Следующий запуск задачи",
+			zap.String("job", j.name),
+			zap.Time("at", next),
+		)
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.start(ctx, j, runs)
+	}
+}
+
+// start запускает задачу в отдельной горутине, пропуская запуск, если предыдущий еще не завершен
+func (s *Scheduler) start(ctx context.Context, j *job, runs *sync.WaitGroup) {
+	if !j.running.CompareAndSwap(false, true) {
+		s.logger.Warn("Предыдущий запуск задачи еще выполняется, запуск пропущен",
+			zap.String("job", j.name),
+		)
+		return
+	}
+
+	runs.Add(1)
+	go func() {
+		defer runs.Done()
+		defer j.running.Store(false)
+
+		started := time.Now()
+		s.logger.Info("Запуск задачи", zap.String("job", j.name))
+
+		if err := j.run(ctx); err != nil {
+			s.logger.Error("Задача завершилась с ошибкой",
+				zap.String("job", j.name),
+				zap.Duration("duration", time.Since(started)),
+				zap.Error(err),
+			)
+			return
+		}
+
+		s.logger.Info("Задача выполнена",
+			zap.String("job", j.name),
+			zap.Duration("duration", time.Since(started)),
+		)
+	}()
+}
+
+// jitter возвращает случайную задержку в диапазоне [0, Jitter]
+func (s *Scheduler) jitter() time.Duration {
+	if s.config.Jitter <= 0 {
+		return 0
+	}
+	return rand.N(s.config.Jitter + 1)
+}
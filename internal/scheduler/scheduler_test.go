@@ -0,0 +1,113 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestSchedulerRun проверяет запуск задач по расписанию согласно шаблону GIVEN-WHEN-THEN
+func TestSchedulerRun(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	t.Run("периодический запуск с запуском при старте", func(t *testing.T) {
+		// GIVEN: Задача с периодом 50мс
+		var runs int32
+		s := NewScheduler(Config{RunOnStart: true}, logger)
+		s.AddSchedule("fast", Every(50*time.Millisecond), func(ctx context.Context) error {
+			atomic.AddInt32(&runs, 1)
+			return nil
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 180*time.Millisecond)
+		defer cancel()
+
+		// WHEN: Запускаем планировщик до истечения контекста
+		s.Run(ctx)
+
+		// THEN: Задача выполнена при старте и затем по расписанию
+		assert.GreaterOrEqual(t, atomic.LoadInt32(&runs), int32(3))
+	})
+
+	t.Run("защита от наложения запусков", func(t *testing.T) {
+		// GIVEN: Задача, которая выполняется дольше периода расписания
+		var inFlight, maxInFlight, runs int32
+		s := NewScheduler(Config{RunOnStart: true}, logger)
+		s.AddSchedule("slow", Every(20*time.Millisecond), func(ctx context.Context) error {
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			if current > atomic.LoadInt32(&maxInFlight) {
+				atomic.StoreInt32(&maxInFlight, current)
+			}
+			atomic.AddInt32(&runs, 1)
+			time.Sleep(100 * time.Millisecond)
+			return nil
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+		defer cancel()
+
+		// WHEN: Запускаем планировщик
+		s.Run(ctx)
+
+		// THEN: Одновременно выполнялся только один запуск, лишние пропущены
+		assert.Equal(t, int32(1), atomic.LoadInt32(&maxInFlight))
+		assert.LessOrEqual(t, atomic.LoadInt32(&runs), int32(3))
+	})
+
+	t.Run("ожидание выполняющихся задач при остановке", func(t *testing.T) {
+		// GIVEN: Задача, которая завершается только после отмены контекста
+		var finished atomic.Bool
+		s := NewScheduler(Config{RunOnStart: true}, logger)
+		s.AddSchedule("long", Every(time.Hour), func(ctx context.Context) error {
+			<-ctx.Done()
+			time.Sleep(50 * time.Millisecond)
+			finished.Store(true)
+			return ctx.Err()
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// WHEN: Запускаем планировщик
+		s.Run(ctx)
+
+		// THEN: Run вернул управление только после завершения задачи
+		assert.True(t, finished.Load())
+	})
+}
+
+func TestParseSchedule(t *testing.T) {
+	from := time.Date(2025, 5, 1, 10, 7, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		spec     string
+		expected time.Time
+		wantErr  bool
+	}{
+		{name: "Фиксированный период", spec: "30m", expected: from.Add(30 * time.Minute)},
+		{name: "Cron-выражение", spec: "*/15 * * * *", expected: time.Date(2025, 5, 1, 10, 15, 0, 0, time.UTC)},
+		{name: "Дескриптор", spec: "@hourly", expected: time.Date(2025, 5, 1, 11, 0, 0, 0, time.UTC)},
+		{name: "Нулевой период", spec: "0s", wantErr: true},
+		{name: "Некорректное выражение", spec: "каждый час", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tc.spec)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, schedule.Next(from))
+		})
+	}
+}
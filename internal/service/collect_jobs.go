@@ -1,46 +1,167 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/parser"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
 	"go.uber.org/zap"
 )
 
-func (s *service) CollectJobs() error {
+// CollectJobs запускает парсеры по очереди, сохраняет собранные вакансии и возвращает отчеты о запусках.
+// При отмене ctx уже собранные вакансии все равно сохраняются, но last_post_id каналов, прерванных
+// посреди обхода, не сдвигается за несобранные посты. Оставшиеся парсеры не запускаются,
+// а метод возвращает ошибку контекста
+func (s *service) CollectJobs(ctx context.Context) ([]model.RunReport, error) {
+	var reports []model.RunReport
+
 	for _, parser := range s.parsers {
-		jobs, err := parser.ParseJobs()
+		if err := ctx.Err(); err != nil {
+			s.logger.Warn("Сбор вакансий прерван", zap.Error(err))
+			return reports, err
+		}
 
-		if err != nil {
-			s.logger.Warn(
-				"Parser returned error while parsing jobs",
-				zap.String("Parser", parser.Name()),
-				zap.Error(err),
-			)
-			continue
+		reports = append(reports, s.collectFromParser(ctx, parser))
+	}
+
+	return reports, ctx.Err()
+}
+
+// CollectJobsFrom запускает только парсер с указанным именем, сохраняет собранные им вакансии
+// и возвращает отчет о запуске
+func (s *service) CollectJobsFrom(ctx context.Context, parserName string) (model.RunReport, error) {
+	op := "service.CollectJobsFrom"
+
+	for _, parser := range s.parsers {
+		if parser.Name() == parserName {
+			return s.collectFromParser(ctx, parser), ctx.Err()
 		}
+	}
+
+	return model.RunReport{}, fmt.Errorf("%s: парсер %q не найден", op, parserName)
+}
+
+// collectFromParser запускает один парсер, сохраняет собранные им вакансии и записывает отчет о запуске.
+// Парсеры с разбивкой по каналам (parser.ChannelParser) сохраняют в отчет результат каждого канала
+func (s *service) collectFromParser(ctx context.Context, p parser.Parser) model.RunReport {
+	report := model.RunReport{Parser: p.Name(), StartedAt: time.Now()}
+
+	var channels []model.ParsedChannel
+	var err error
+
+	if channelParser, ok := p.(parser.ChannelParser); ok {
+		channels, err = channelParser.ParseChannels(ctx)
+	} else {
+		var jobs []model.JobRaw
+		jobs, err = p.ParseJobs(ctx)
+		channels = []model.ParsedChannel{{Jobs: jobs}}
+	}
+
+	var jobs []model.JobRaw
+	var incomplete []string
+	for _, channel := range channels {
+		jobs = append(jobs, channel.Jobs...)
+		if channel.Channel != "" && channel.Incomplete() {
+			incomplete = append(incomplete, channel.Channel)
+		}
+	}
+
+	if err != nil {
+		report.Error = err.Error()
 
-		if len(jobs) == 0 {
+		s.logger.Warn(
+			"Parser returned error while parsing jobs",
+			zap.String("Parser", p.Name()),
+			zap.Int("Jobs parsed", len(jobs)),
+			zap.Error(err),
+		)
+	}
+
+	// Сохранение не прерываем при отмене запуска, чтобы частичный результат был зафиксирован.
+	// Каналы, прерванные отменой, получают ошибку контекста и попадают в incomplete
+	saveCtx := context.WithoutCancel(ctx)
+
+	// Парсер мог вернуть частичный результат вместе с ошибкой - сохраняем то, что удалось собрать.
+	// last_post_id каналов с неполным обходом не сдвигается за пропуск в собранных постах
+	var saved model.SaveReport
+	var saveErr error
+
+	if len(jobs) == 0 {
+		if err == nil {
 			s.logger.Warn(
 				"No jobs found while parsing",
-				zap.String("Parser", parser.Name()),
+				zap.String("Parser", p.Name()),
 			)
-			continue
 		}
-
-		saved, err := s.repository.SaveJobs(jobs)
-		if err != nil {
+	} else {
+		saved, saveErr = s.repository.SaveJobs(saveCtx, jobs, incomplete)
+		if saveErr != nil {
 			s.logger.Warn(
 				"Error saving jobs from parser",
-				zap.String("Parser", parser.Name()),
-				zap.Error(err),
+				zap.String("Parser", p.Name()),
+				zap.Error(saveErr),
 			)
+		}
+	}
+
+	report.PostCounts = saved.PostCounts
+	report.Seen = len(jobs)
+	if saveErr != nil && report.Error == "" {
+		report.Error = saveErr.Error()
+	}
+
+	for _, channel := range channels {
+		if channel.Channel == "" {
 			continue
 		}
 
-		s.logger.Info(
-			"Parsing successfully completed",
-			zap.String("Parser", parser.Name()),
-			zap.Int("Jobs saved", saved),
+		run := model.ChannelRun{
+			Channel:    channel.Channel,
+			StartedAt:  channel.StartedAt,
+			FinishedAt: channel.FinishedAt,
+			HTTPStatus: channel.HTTPStatus,
+			PostCounts: saved.Channels[channel.Channel],
+		}
+		run.Seen = len(channel.Jobs)
+
+		switch {
+		case channel.Err != nil:
+			run.Error = channel.Err.Error()
+			run.Interrupted = errors.Is(channel.Err, parser.ErrRateLimited) ||
+				ctx.Err() != nil && errors.Is(channel.Err, ctx.Err())
+		case saveErr != nil && len(channel.Jobs) > 0:
+			run.Error = saveErr.Error()
+		}
+
+		if run.Error != "" {
+			report.ChannelsFailed++
+		}
+
+		report.Channels = append(report.Channels, run)
+	}
+
+	report.FinishedAt = time.Now()
+
+	if err := s.repository.SaveScrapeRun(saveCtx, &report); err != nil {
+		s.logger.Warn(
+			"Error saving scrape run report",
+			zap.String("Parser", p.Name()),
+			zap.Error(err),
 		)
 	}
 
-	return nil
+	s.logger.Info(
+		"Parsing completed",
+		zap.String("Parser", p.Name()),
+		zap.Int("Jobs seen", report.Seen),
+		zap.Int("Jobs saved", report.New),
+		zap.Int("Jobs updated", report.Updated),
+		zap.Int("Channels failed", report.ChannelsFailed),
+		zap.Duration("Duration", report.Duration()),
+	)
+
+	return report
 }
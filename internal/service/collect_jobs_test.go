@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zalhonan/remotejobs-web-scraper/internal/parser"
 	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
 	"github.com/zalhonan/remotejobs-web-scraper/model"
@@ -29,14 +33,22 @@ func TestCollectJobs(t *testing.T) {
 		}
 
 		// Создаем тестовый сервис
-		service := NewService(mockRepo, []parser.Parser{mockParser}, logger, ctx)
+		service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
 
 		// WHEN: Вызываем метод сбора вакансий
-		err := service.CollectJobs()
+		reports, err := service.CollectJobs(ctx)
 
 		// THEN: Проверяем результаты
 		assert.NoError(t, err)
 		assert.Equal(t, 2, mockRepo.SavedJobs) // Должно быть сохранено 2 вакансии
+
+		// THEN: Отчет о запуске возвращен и сохранен
+		require.Len(t, reports, 1)
+		assert.Equal(t, "MockParser", reports[0].Parser)
+		assert.Equal(t, 2, reports[0].Seen)
+		assert.Equal(t, 2, reports[0].New)
+		assert.Empty(t, reports[0].Channels)
+		assert.Equal(t, reports, mockRepo.Runs)
 	})
 
 	t.Run("успешный сбор вакансий из нескольких парсеров", func(t *testing.T) {
@@ -59,10 +71,10 @@ func TestCollectJobs(t *testing.T) {
 		mockParser2.ParserName = "Parser2"
 
 		// Создаем тестовый сервис с двумя парсерами
-		service := NewService(mockRepo, []parser.Parser{mockParser1, mockParser2}, logger, ctx)
+		service := NewService(mockRepo, []parser.Parser{mockParser1, mockParser2}, logger)
 
 		// WHEN: Вызываем метод сбора вакансий
-		err := service.CollectJobs()
+		_, err := service.CollectJobs(ctx)
 
 		// THEN: Проверяем результаты
 		assert.NoError(t, err)
@@ -79,14 +91,18 @@ func TestCollectJobs(t *testing.T) {
 		mockParser.ShouldError = true
 
 		// Создаем тестовый сервис
-		service := NewService(mockRepo, []parser.Parser{mockParser}, logger, ctx)
+		service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
 
 		// WHEN: Вызываем метод сбора вакансий
-		err := service.CollectJobs()
+		reports, err := service.CollectJobs(ctx)
 
 		// THEN: Метод должен продолжить выполнение, несмотря на ошибку парсера
 		assert.NoError(t, err)
 		assert.Equal(t, 0, mockRepo.SavedJobs) // Ничего не должно быть сохранено
+
+		// THEN: Ошибка парсера записана в отчет
+		require.Len(t, reports, 1)
+		assert.Equal(t, "mock error parsing jobs", reports[0].Error)
 	})
 
 	t.Run("обработка ошибки сохранения", func(t *testing.T) {
@@ -101,10 +117,10 @@ func TestCollectJobs(t *testing.T) {
 		}
 
 		// Создаем тестовый сервис
-		service := NewService(mockRepo, []parser.Parser{mockParser}, logger, ctx)
+		service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
 
 		// WHEN: Вызываем метод сбора вакансий
-		err := service.CollectJobs()
+		_, err := service.CollectJobs(ctx)
 
 		// THEN: Метод должен продолжить выполнение, несмотря на ошибку сохранения
 		assert.NoError(t, err)
@@ -120,13 +136,216 @@ func TestCollectJobs(t *testing.T) {
 		// Jobs по умолчанию инициализируется как пустой слайс
 
 		// Создаем тестовый сервис
-		service := NewService(mockRepo, []parser.Parser{mockParser}, logger, ctx)
+		service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
 
 		// WHEN: Вызываем метод сбора вакансий
-		err := service.CollectJobs()
+		_, err := service.CollectJobs(ctx)
 
 		// THEN: Метод должен корректно обработать пустой список
 		assert.NoError(t, err)
 		assert.Equal(t, 0, mockRepo.SavedJobs) // Ничего не должно быть сохранено
 	})
+
+	t.Run("сохранение частичного результата парсера с ошибкой", func(t *testing.T) {
+		// GIVEN: Парсер вернул часть вакансий вместе с ошибкой по одному из каналов
+		mockRepo := test.NewMockRepository(logger)
+
+		mockParser := test.NewMockParser(logger)
+		mockParser.Jobs = []model.JobRaw{
+			test.CreateMockJob(1, "golang"),
+		}
+		mockParser.Err = errors.New("channel unavailable")
+
+		service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
+
+		// WHEN: Вызываем метод сбора вакансий
+		_, err := service.CollectJobs(ctx)
+
+		// THEN: Собранные вакансии сохранены несмотря на ошибку
+		assert.NoError(t, err)
+		assert.Equal(t, 1, mockRepo.SavedJobs)
+	})
+
+	t.Run("прерывание сбора при отмене контекста", func(t *testing.T) {
+		// GIVEN: Контекст запуска уже отменен
+		mockRepo := test.NewMockRepository(logger)
+
+		mockParser := test.NewMockParser(logger)
+		mockParser.Jobs = []model.JobRaw{
+			test.CreateMockJob(1, "golang"),
+		}
+
+		service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
+
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		// WHEN: Вызываем метод сбора вакансий
+		_, err := service.CollectJobs(cancelledCtx)
+
+		// THEN: Возвращена ошибка отмены, парсеры не запускались
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, mockRepo.SavedJobs)
+	})
+
+	t.Run("сбор вакансий из одного парсера по имени", func(t *testing.T) {
+		// GIVEN: Сервис с двумя парсерами
+		mockRepo := test.NewMockRepository(logger)
+
+		mockParser1 := test.NewMockParser(logger)
+		mockParser1.Jobs = []model.JobRaw{
+			test.CreateMockJob(1, "golang"),
+			test.CreateMockJob(2, "java"),
+		}
+		mockParser1.ParserName = "Parser1"
+
+		mockParser2 := test.NewMockParser(logger)
+		mockParser2.Jobs = []model.JobRaw{
+			test.CreateMockJob(3, "javascript"),
+		}
+		mockParser2.ParserName = "Parser2"
+
+		service := NewService(mockRepo, []parser.Parser{mockParser1, mockParser2}, logger)
+
+		// WHEN: Запускаем только первый парсер
+		_, err := service.CollectJobsFrom(ctx, "Parser1")
+
+		// THEN: Сохранены вакансии только первого парсера
+		assert.NoError(t, err)
+		assert.Equal(t, 2, mockRepo.SavedJobs)
+
+		// THEN: Для неизвестного парсера возвращается ошибка
+		_, err = service.CollectJobsFrom(ctx, "Unknown")
+		assert.Error(t, err)
+	})
+}
+
+// channelParser - мок-парсер с разбивкой результатов по каналам
+type channelParser struct {
+	*test.MockParser
+	channels []model.ParsedChannel
+}
+
+func (p *channelParser) ParseChannels(ctx context.Context) ([]model.ParsedChannel, error) {
+	return p.channels, nil
+}
+
+// TestCollectJobsChannelReport проверяет отчет о запуске парсера с разбивкой по каналам
+func TestCollectJobsChannelReport(t *testing.T) {
+	// GIVEN: Парсер собрал вакансии из одного канала, второй канал вернул ошибку, третий прерван ограничением частоты
+	logger := zaptest.NewLogger(t)
+	mockRepo := test.NewMockRepository(logger)
+
+	started := time.Now().Add(-time.Minute)
+	mockParser := &channelParser{
+		MockParser: test.NewMockParser(logger),
+		channels: []model.ParsedChannel{
+			{
+				Channel:    "test_channel",
+				Jobs:       []model.JobRaw{test.CreateMockJob(1, "golang"), test.CreateMockJob(2, "java")},
+				StartedAt:  started,
+				FinishedAt: started.Add(2 * time.Second),
+				HTTPStatus: 200,
+			},
+			{
+				Channel:    "broken_channel",
+				StartedAt:  started,
+				FinishedAt: started.Add(time.Second),
+				HTTPStatus: 404,
+				Err:        errors.New("status 404"),
+			},
+			{
+				Channel:    "limited_channel",
+				StartedAt:  started,
+				FinishedAt: started,
+				Err:        fmt.Errorf("fetch: %w", parser.ErrRateLimited),
+			},
+		},
+	}
+
+	service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
+
+	// WHEN: Запускаем парсер
+	report, err := service.CollectJobsFrom(context.Background(), "MockParser")
+
+	// THEN: Отчет содержит итоги и результаты каждого канала
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), report.ID)
+	assert.Equal(t, 2, report.Seen)
+	assert.Equal(t, 2, report.New)
+	assert.Equal(t, 2, report.ChannelsFailed)
+
+	require.Len(t, report.Channels, 3)
+	assert.Equal(t, model.ChannelRun{
+		Channel:    "test_channel",
+		StartedAt:  started,
+		FinishedAt: started.Add(2 * time.Second),
+		HTTPStatus: 200,
+		PostCounts: model.PostCounts{Seen: 2, New: 2},
+	}, report.Channels[0])
+	assert.Equal(t, "status 404", report.Channels[1].Error)
+	assert.Equal(t, 404, report.Channels[1].HTTPStatus)
+	assert.Equal(t, time.Second, report.Channels[1].Duration())
+	assert.False(t, report.Channels[1].Interrupted)
+
+	// THEN: Ограничение частоты запросов не считается сбоем канала
+	assert.True(t, report.Channels[2].Interrupted)
+}
+
+// TestCollectJobsIncompleteChannels проверяет, что каналы с неполным обходом не сдвигают last_post_id
+func TestCollectJobsIncompleteChannels(t *testing.T) {
+	// GIVEN: Один канал обойден полностью, у второго вторая страница вернула ошибку, третий уперся в глубину
+	logger := zaptest.NewLogger(t)
+	mockRepo := test.NewMockRepository(logger)
+
+	mockParser := &channelParser{
+		MockParser: test.NewMockParser(logger),
+		channels: []model.ParsedChannel{
+			{Channel: "test_channel", Jobs: []model.JobRaw{test.CreateMockJob(1, "golang")}},
+			{Channel: "page_error_channel", Jobs: []model.JobRaw{test.CreateMockJob(2, "java")}, Err: errors.New("page 2: status 500")},
+			{Channel: "deep_channel", Jobs: []model.JobRaw{test.CreateMockJob(3, "golang")}, Truncated: true},
+		},
+	}
+
+	service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
+
+	// WHEN: Запускаем парсер
+	report, err := service.CollectJobsFrom(context.Background(), "MockParser")
+
+	// THEN: Все собранные посты сохранены, а неполные каналы переданы в SaveJobs отдельно
+	require.NoError(t, err)
+	assert.Equal(t, 3, mockRepo.SavedJobs)
+	assert.Equal(t, []string{"page_error_channel", "deep_channel"}, mockRepo.IncompleteChannels)
+
+	// THEN: Ограничение глубины не считается сбоем канала
+	assert.Equal(t, 1, report.ChannelsFailed)
+}
+
+// TestCollectJobsCancelledChannel проверяет сохранение канала, прерванного отменой запуска
+func TestCollectJobsCancelledChannel(t *testing.T) {
+	// GIVEN: Запуск отменен, пока канал обходил вторую страницу
+	logger := zaptest.NewLogger(t)
+	mockRepo := test.NewMockRepository(logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mockParser := &channelParser{
+		MockParser: test.NewMockParser(logger),
+		channels: []model.ParsedChannel{
+			{Channel: "test_channel", Jobs: []model.JobRaw{test.CreateMockJob(1, "golang")}, Err: fmt.Errorf("page 2: %w", context.Canceled)},
+		},
+	}
+
+	service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
+
+	// WHEN: Запускаем парсер
+	report, err := service.CollectJobsFrom(ctx, "MockParser")
+
+	// THEN: Собранные посты сохранены, но канал передан как неполный и last_post_id не сдвинется
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, mockRepo.SavedJobs)
+	assert.Equal(t, []string{"test_channel"}, mockRepo.IncompleteChannels)
+	require.Len(t, report.Channels, 1)
+	assert.True(t, report.Channels[0].Interrupted)
 }
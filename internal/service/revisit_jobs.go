@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/parser"
+	"go.uber.org/zap"
+)
+
+// RevisitJobs заново собирает недавние посты парсеров, которые это поддерживают.
+// Измененные посты обновляют сохраненные вакансии с сохранением прежнего текста,
+// посты с пометкой о закрытии закрывают вакансию, а исчезнувшие посты помечают ее удаленной
+func (s *service) RevisitJobs(ctx context.Context) error {
+	for _, p := range s.parsers {
+		if err := ctx.Err(); err != nil {
+			s.logger.Warn("Повторный обход прерван", zap.Error(err))
+			return err
+		}
+
+		if revisiter, ok := p.(parser.Revisiter); ok {
+			s.revisitFromParser(ctx, revisiter)
+		}
+	}
+
+	return ctx.Err()
+}
+
+// RevisitJobsFrom заново собирает недавние посты только парсера с указанным именем
+func (s *service) RevisitJobsFrom(ctx context.Context, parserName string) error {
+	op := "service.RevisitJobsFrom"
+
+	for _, p := range s.parsers {
+		if revisiter, ok := p.(parser.Revisiter); ok && p.Name() == parserName {
+			s.revisitFromParser(ctx, revisiter)
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("%s: парсер %q не найден или не поддерживает повторный обход", op, parserName)
+}
+
+// revisitFromParser заново собирает посты одного парсера, сохраняет их и помечает удаленные вакансии
+func (s *service) revisitFromParser(ctx context.Context, revisiter parser.Revisiter) {
+	revisited, err := revisiter.RevisitJobs(ctx)
+	if err != nil {
+		s.logger.Warn(
+			"Parser returned error while revisiting jobs",
+			zap.String("Parser", revisiter.Name()),
+			zap.Int("Channels revisited", len(revisited)),
+			zap.Error(err),
+		)
+	}
+
+	// Сохранение не прерываем при отмене запуска, чтобы частичный результат был зафиксирован
+	saveCtx := context.WithoutCancel(ctx)
+
+	deleted := 0
+	for _, channel := range revisited {
+		// Повторный обход идет от новых постов до границы окна, а не до last_post_id, поэтому
+		// считается неполным: last_post_id сдвигается, только если посты продолжают сохраненные без пропуска
+		if _, err := s.repository.SaveJobs(saveCtx, channel.Jobs, []string{channel.Channel}); err != nil {
+			s.logger.Warn(
+				"Error saving revisited jobs",
+				zap.String("Parser", revisiter.Name()),
+				zap.String("Channel", channel.Channel),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		count, err := s.repository.MarkDeletedJobs(saveCtx, channel)
+		if err != nil {
+			s.logger.Warn(
+				"Error marking deleted jobs",
+				zap.String("Parser", revisiter.Name()),
+				zap.String("Channel", channel.Channel),
+				zap.Error(err),
+			)
+			continue
+		}
+		deleted += count
+	}
+
+	s.logger.Info(
+		"Revisit successfully completed",
+		zap.String("Parser", revisiter.Name()),
+		zap.Int("Channels revisited", len(revisited)),
+		zap.Int("Jobs deleted", deleted),
+	)
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/parser"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestRevisitJobs проверяет повторный обход постов согласно шаблону GIVEN-WHEN-THEN
+func TestRevisitJobs(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	ctx := context.Background()
+
+	t.Run("посты сохраняются, а диапазон передается для пометки удаленных", func(t *testing.T) {
+		// GIVEN: Парсер вернул два канала после повторного обхода
+		mockRepo := test.NewMockRepository(logger)
+		mockParser := test.NewMockParser(logger)
+		mockParser.Revisited = []model.RevisitedChannel{
+			{Channel: "test_channel", FromPostID: 1, ToPostID: 3, Jobs: []model.JobRaw{
+				test.CreateMockJob(1, "golang"),
+				test.CreateMockJob(3, "golang"),
+			}},
+			{Channel: "other_channel", FromPostID: 10, ToPostID: 10},
+		}
+		service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
+
+		// WHEN: Выполняем повторный обход
+		err := service.RevisitJobs(ctx)
+
+		// THEN: Оба канала переданы в MarkDeletedJobs
+		assert.NoError(t, err)
+		assert.Equal(t, mockParser.Revisited, mockRepo.Revisited)
+	})
+
+	t.Run("ошибка сохранения пропускает пометку удаленных", func(t *testing.T) {
+		// GIVEN: Репозиторий возвращает ошибки
+		mockRepo := test.NewMockRepository(logger)
+		mockRepo.ShouldError = true
+		mockParser := test.NewMockParser(logger)
+		mockParser.Revisited = []model.RevisitedChannel{{Channel: "test_channel", FromPostID: 1, ToPostID: 3}}
+		service := NewService(mockRepo, []parser.Parser{mockParser}, logger)
+
+		// WHEN: Выполняем повторный обход
+		err := service.RevisitJobs(ctx)
+
+		// THEN: Ошибка только залогирована, посты не помечены удаленными
+		assert.NoError(t, err)
+		assert.Empty(t, mockRepo.Revisited)
+	})
+
+	t.Run("неизвестный парсер", func(t *testing.T) {
+		// GIVEN: Сервис с одним парсером
+		service := NewService(test.NewMockRepository(logger), []parser.Parser{test.NewMockParser(logger)}, logger)
+
+		// WHEN: Запрашиваем обход парсера с другим именем
+		err := service.RevisitJobsFrom(ctx, "unknown")
+
+		// THEN: Возвращается ошибка
+		assert.Error(t, err)
+	})
+}
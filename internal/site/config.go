@@ -0,0 +1,27 @@
+package site
+
+// Config задает параметры выгрузки sitemap и статического сайта
+type Config struct {
+	// BaseURL - публичный адрес сайта, из которого строятся все ссылки, например https://example.com
+	BaseURL string `yaml:"base_url"`
+	// Dir - каталог для sitemap и страниц сайта
+	Dir string `yaml:"dir"`
+	// HTML включает выгрузку статических страниц; без него пишется только sitemap
+	HTML bool `yaml:"html"`
+	// JobURL - шаблон адреса страницы вакансии, которую отдает не выгрузка, а свой сайт, например
+	// https://example.com/jobs/{slug}. Обязателен без HTML: тогда sitemap состоит только из этих адресов
+	JobURL string `yaml:"job_url"`
+	// Title - название сайта в заголовках страниц
+	Title string `yaml:"title"`
+	// Templates - каталог со своими шаблонами index.html, technology.html, job.html и layout.html;
+	// шаблоны из него заменяют встроенные с тем же именем. Пустой - только встроенные шаблоны
+	Templates string `yaml:"templates"`
+}
+
+// DefaultConfig возвращает конфигурацию выгрузки по умолчанию
+func DefaultConfig() Config {
+	return Config{
+		Dir:   "site",
+		Title: "Удаленные вакансии",
+	}
+}
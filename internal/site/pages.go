@@ -0,0 +1,184 @@
+package site
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/utils"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// Каталоги страниц технологий и вакансий относительно корня сайта
+const (
+	technologiesDir = "technologies"
+	jobsDir         = "jobs"
+)
+
+// indexJobsLimit - сколько последних вакансий показывается на главной странице
+const indexJobsLimit = 50
+
+// Page - общие поля всех страниц, доступные в шаблонах
+type Page struct {
+	SiteTitle string
+	// Title - заголовок страницы, пустой на главной
+	Title   string
+	URL     string
+	HomeURL string
+}
+
+// JobLink - вакансия в списке
+type JobLink struct {
+	Title          string
+	URL            string
+	MainTechnology string
+	DatePosted     time.Time
+}
+
+// TechnologyLink - технология со ссылкой на ее страницу
+type TechnologyLink struct {
+	Technology string
+	URL        string
+	// Jobs - число активных вакансий технологии
+	Jobs int
+}
+
+// IndexPage - данные шаблона index.html
+type IndexPage struct {
+	Page
+	Technologies []TechnologyLink
+	Jobs         []JobLink
+}
+
+// TechnologyPage - данные шаблона technology.html
+type TechnologyPage struct {
+	Page
+	Technology string
+	Jobs       []JobLink
+}
+
+// JobPage - данные шаблона job.html
+type JobPage struct {
+	Page
+	Job model.JobRaw
+	// Technologies - технологии вакансии, у которых есть страница
+	Technologies []TechnologyLink
+}
+
+// writePages записывает главную страницу, страницы технологий и вакансий в каталог dir и удаляет
+// страницы технологий и вакансий от прошлых выгрузок, которых больше нет. Возвращает число страниц
+func (e *Exporter) writePages(dir string, jobs []model.JobRaw, technologies []technologyJobs) (int, error) {
+	pages := 0
+
+	links := make(map[string]TechnologyLink, len(technologies))
+	index := IndexPage{Page: e.page("", e.homeURL())}
+	for _, group := range technologies {
+		link := TechnologyLink{
+			Technology: group.technology,
+			URL:        e.technologyURL(group.technology),
+			Jobs:       len(group.jobs),
+		}
+		links[group.technology] = link
+		index.Technologies = append(index.Technologies, link)
+	}
+	for _, job := range jobs[:min(len(jobs), indexJobsLimit)] {
+		index.Jobs = append(index.Jobs, e.jobLink(job))
+	}
+
+	if err := e.writePage(filepath.Join(dir, "index.html"), indexTemplate, index); err != nil {
+		return pages, err
+	}
+	pages++
+
+	keep := make(map[string]bool, len(technologies))
+	for _, group := range technologies {
+		slug := utils.TechnologySlug(group.technology)
+		keep[slug] = true
+
+		page := TechnologyPage{
+			Page:       e.page("Вакансии "+group.technology, e.technologyURL(group.technology)),
+			Technology: group.technology,
+		}
+		for _, job := range group.jobs {
+			page.Jobs = append(page.Jobs, e.jobLink(job))
+		}
+
+		if err := e.writePage(filepath.Join(dir, technologiesDir, slug, "index.html"), technologyTemplate, page); err != nil {
+			return pages, err
+		}
+		pages++
+	}
+	if err := utils.RemoveStaleDirs(filepath.Join(dir, technologiesDir), keep); err != nil {
+		return pages, err
+	}
+
+	keep = make(map[string]bool, len(jobs))
+	for _, job := range jobs {
+		keep[job.Slug] = true
+
+		page := JobPage{
+			Page: e.page(job.DisplayTitle(), e.jobURL(job)),
+			Job:  job,
+		}
+		for _, tech := range job.Technologies {
+			if link, ok := links[tech.Technology]; ok {
+				page.Technologies = append(page.Technologies, link)
+			}
+		}
+
+		if err := e.writePage(filepath.Join(dir, jobsDir, job.Slug, "index.html"), jobTemplate, page); err != nil {
+			return pages, err
+		}
+		pages++
+	}
+	if err := utils.RemoveStaleDirs(filepath.Join(dir, jobsDir), keep); err != nil {
+		return pages, err
+	}
+
+	return pages, nil
+}
+
+func (e *Exporter) page(title, url string) Page {
+	return Page{
+		SiteTitle: e.config.Title,
+		Title:     title,
+		URL:       url,
+		HomeURL:   e.homeURL(),
+	}
+}
+
+func (e *Exporter) jobLink(job model.JobRaw) JobLink {
+	return JobLink{
+		Title:          job.DisplayTitle(),
+		URL:            e.jobURL(job),
+		MainTechnology: job.MainTechnology,
+		DatePosted:     job.DatePosted,
+	}
+}
+
+// writePage выполняет шаблон name с данными data и атомарно записывает результат в path
+func (e *Exporter) writePage(path, name string, data any) error {
+	var buf bytes.Buffer
+	if err := e.templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return fmt.Errorf("шаблон %s: %w", name, err)
+	}
+
+	return utils.WriteFileAtomic(path, buf.Bytes())
+}
+
+// removePages удаляет из каталога dir главную страницу, страницы технологий и вакансий
+func removePages(dir string) error {
+	if err := os.Remove(filepath.Join(dir, "index.html")); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for _, name := range []string{technologiesDir, jobsDir} {
+		if err := os.RemoveAll(filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/utils"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+)
+
+// exportPageSize - размер страницы при чтении всех активных вакансий
+const exportPageSize = 1000
+
+// jobURLSlug - место slug вакансии в шаблоне job_url
+const jobURLSlug = "{slug}"
+
+// Report - итог выгрузки
+type Report struct {
+	Jobs         int
+	Technologies int
+	// URLs - число ссылок во всех файлах sitemap
+	URLs int
+	// Sitemaps - число файлов sitemap без учета индекса
+	Sitemaps int
+	// Pages - число записанных HTML-страниц, 0 без выгрузки сайта
+	Pages int
+}
+
+// Exporter выгружает sitemap и статический сайт из активных вакансий. Адреса страниц:
+// <base_url>/ - главная, <base_url>/technologies/<slug технологии>/ - вакансии технологии,
+// <base_url>/jobs/<slug вакансии>/ - вакансия. Без выгрузки страниц в sitemap попадают только
+// вакансии по шаблону job_url, так как других страниц нет
+type Exporter struct {
+	repository repository.JobsRepository
+	config     Config
+	templates  *template.Template
+	// maxURLs - максимум ссылок в одном файле sitemap
+	maxURLs int
+}
+
+// NewExporter создает выгрузку и разбирает шаблоны страниц
+func NewExporter(repository repository.JobsRepository, config Config) (*Exporter, error) {
+	op := "site.NewExporter"
+
+	if config.BaseURL == "" {
+		return nil, fmt.Errorf("%s: не задан base_url", op)
+	}
+	if !config.HTML && !strings.Contains(config.JobURL, jobURLSlug) {
+		return nil, fmt.Errorf("%s: без html нужен job_url с %s, иначе в sitemap нечего включить", op, jobURLSlug)
+	}
+
+	templates, err := parseTemplates(config.Templates)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Exporter{
+		repository: repository,
+		config:     config,
+		templates:  templates,
+		maxURLs:    sitemapMaxURLs,
+	}, nil
+}
+
+// technologyJobs - активные вакансии одной технологии от новых к старым
+type technologyJobs struct {
+	technology string
+	jobs       []model.JobRaw
+}
+
+// Export записывает sitemap и, если включено, статические страницы в каталог dir
+func (e *Exporter) Export(ctx context.Context, dir string) (Report, error) {
+	op := "site.Export"
+
+	jobs, err := e.loadJobs(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	technologies, err := e.groupByTechnology(ctx, jobs)
+	if err != nil {
+		return Report{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	report := Report{
+		Jobs:         len(jobs),
+		Technologies: len(technologies),
+	}
+
+	var urls []sitemapURL
+	if e.config.HTML {
+		if report.Pages, err = e.writePages(dir, jobs, technologies); err != nil {
+			return report, fmt.Errorf("%s: %w", op, err)
+		}
+
+		urls = append(urls, sitemapURL{Loc: e.homeURL(), LastMod: lastParsed(jobs)})
+		for _, group := range technologies {
+			urls = append(urls, sitemapURL{Loc: e.technologyURL(group.technology), LastMod: lastParsed(group.jobs)})
+		}
+	} else if err := removePages(dir); err != nil {
+		// Страницы прошлых выгрузок с HTML больше не обновляются и не попадают в sitemap
+		return report, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, job := range jobs {
+		urls = append(urls, sitemapURL{Loc: e.jobURL(job), LastMod: job.DateParsed})
+	}
+
+	if report.Sitemaps, err = e.writeSitemaps(dir, urls); err != nil {
+		return report, fmt.Errorf("%s: %w", op, err)
+	}
+	report.URLs = len(urls)
+
+	return report, nil
+}
+
+// loadJobs читает все активные вакансии с непустым slug от новых к старым
+func (e *Exporter) loadJobs(ctx context.Context) ([]model.JobRaw, error) {
+	var jobs []model.JobRaw
+
+	filter := model.JobFilter{Limit: exportPageSize}
+	for {
+		page, err := e.repository.ListJobs(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, job := range page.Jobs {
+			if job.Slug != "" {
+				jobs = append(jobs, job)
+			}
+		}
+
+		if page.Next == nil {
+			return jobs, nil
+		}
+		filter.After = page.Next
+	}
+}
+
+// groupByTechnology раскладывает вакансии по всем найденным в них технологиям. Технологии без
+// вакансий пропускаются, остальные упорядочены по убыванию числа вакансий, затем по sort_order
+func (e *Exporter) groupByTechnology(ctx context.Context, jobs []model.JobRaw) ([]technologyJobs, error) {
+	technologies, err := e.repository.GetTechnologies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string][]model.JobRaw)
+	for _, job := range jobs {
+		for _, tech := range job.Technologies {
+			byName[tech.Technology] = append(byName[tech.Technology], job)
+		}
+	}
+
+	slugs := make(map[string]string)
+	var groups []technologyJobs
+	for _, tech := range technologies {
+		if len(byName[tech.Technology]) == 0 {
+			continue
+		}
+
+		// Две технологии с одинаковым slug перезаписали бы страницы друг друга
+		slug := utils.TechnologySlug(tech.Technology)
+		if other, ok := slugs[slug]; ok {
+			return nil, fmt.Errorf("технологии %q и %q дают одинаковый адрес %q", other, tech.Technology, slug)
+		}
+		slugs[slug] = tech.Technology
+
+		groups = append(groups, technologyJobs{technology: tech.Technology, jobs: byName[tech.Technology]})
+	}
+
+	slices.SortStableFunc(groups, func(a, b technologyJobs) int {
+		return len(b.jobs) - len(a.jobs)
+	})
+
+	return groups, nil
+}
+
+func (e *Exporter) homeURL() string {
+	return strings.TrimSuffix(e.config.BaseURL, "/") + "/"
+}
+
+func (e *Exporter) technologyURL(technology string) string {
+	return e.homeURL() + technologiesDir + "/" + utils.TechnologySlug(technology) + "/"
+}
+
+// jobURL возвращает адрес выгруженной страницы вакансии или, без выгрузки страниц, адрес по job_url
+func (e *Exporter) jobURL(job model.JobRaw) string {
+	if !e.config.HTML {
+		return strings.ReplaceAll(e.config.JobURL, jobURLSlug, url.PathEscape(job.Slug))
+	}
+	return e.homeURL() + jobsDir + "/" + url.PathEscape(job.Slug) + "/"
+}
+
+// lastParsed возвращает самое позднее время сбора вакансий
+func lastParsed(jobs []model.JobRaw) time.Time {
+	var last time.Time
+	for _, job := range jobs {
+		if job.DateParsed.After(last) {
+			last = job.DateParsed
+		}
+	}
+	return last
+}
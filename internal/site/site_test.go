@@ -0,0 +1,267 @@
+package site
+
+import (
+	"context"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalhonan/remotejobs-web-scraper/internal/repository/test"
+	"github.com/zalhonan/remotejobs-web-scraper/model"
+	"go.uber.org/zap/zaptest"
+)
+
+// newTestRepository создает мок-репозиторий с тремя вакансиями golang, C++ и golang
+func newTestRepository(t *testing.T) *test.MockRepository {
+	repo := test.NewMockRepository(zaptest.NewLogger(t))
+
+	posted := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i, technology := range []string{"golang", "C++", "golang"} {
+		job := test.CreateMockJob(int64(i+1), technology)
+		job.DatePosted = posted.AddDate(0, 0, i)
+		job.DateParsed = job.DatePosted.Add(time.Hour)
+		job.Technologies = []model.JobTechnology{{TechnologyID: int64(i + 1), Technology: technology, Score: 3}}
+		repo.Jobs = append(repo.Jobs, job)
+	}
+	repo.Technologies = []model.Technology{
+		test.CreateMockTechnology(1, "C++", 0, "c++"),
+		test.CreateMockTechnology(2, "golang", 1, "go"),
+		test.CreateMockTechnology(3, "java", 2, "java"),
+	}
+
+	return repo
+}
+
+// newTestExporter создает выгрузку с адресом https://example.com; без HTML вакансии ссылаются на /vacancy/<slug>
+func newTestExporter(t *testing.T, html bool) *Exporter {
+	config := DefaultConfig()
+	config.BaseURL = "https://example.com/"
+	config.HTML = html
+	if !html {
+		config.JobURL = "https://example.com/vacancy/{slug}"
+	}
+
+	exporter, err := NewExporter(newTestRepository(t), config)
+	require.NoError(t, err)
+	return exporter
+}
+
+// readURLSet разбирает файл sitemap со списком ссылок
+func readURLSet(t *testing.T, path string) urlSet {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var set urlSet
+	require.NoError(t, xml.Unmarshal(data, &set))
+	return set
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Run("без base_url", func(t *testing.T) {
+		// GIVEN: Конфигурация без адреса сайта
+		// WHEN: Создаем выгрузку
+		_, err := NewExporter(newTestRepository(t), DefaultConfig())
+
+		// THEN: Возвращена ошибка
+		assert.ErrorContains(t, err, "base_url")
+	})
+
+	t.Run("без html и job_url", func(t *testing.T) {
+		// GIVEN: Конфигурация только sitemap без шаблона адреса вакансии
+		config := DefaultConfig()
+		config.BaseURL = "https://example.com"
+
+		// WHEN: Создаем выгрузку
+		_, err := NewExporter(newTestRepository(t), config)
+
+		// THEN: Возвращена ошибка
+		assert.ErrorContains(t, err, "job_url")
+	})
+
+	t.Run("несуществующий каталог шаблонов", func(t *testing.T) {
+		// GIVEN: Каталог шаблонов без файлов
+		config := DefaultConfig()
+		config.BaseURL = "https://example.com"
+		config.HTML = true
+		config.Templates = t.TempDir()
+
+		// WHEN: Создаем выгрузку
+		_, err := NewExporter(newTestRepository(t), config)
+
+		// THEN: Возвращена ошибка
+		assert.ErrorContains(t, err, "нет файлов")
+	})
+}
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("только sitemap", func(t *testing.T) {
+		// GIVEN: Выгрузка без HTML и страницы от прошлой выгрузки с HTML
+		exporter := newTestExporter(t, false)
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("old"), 0644))
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "jobs", "old-job"), 0755))
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "technologies", "golang"), 0755))
+
+		// WHEN: Выгружаем
+		report, err := exporter.Export(ctx, dir)
+
+		// THEN: В sitemap только три вакансии по шаблону job_url
+		require.NoError(t, err)
+		assert.Equal(t, Report{Jobs: 3, Technologies: 2, URLs: 3, Sitemaps: 1}, report)
+
+		set := readURLSet(t, filepath.Join(dir, "sitemap.xml"))
+		require.Len(t, set.URLs, 3)
+		assert.Equal(t, sitemapEntry{Loc: "https://example.com/vacancy/testovaya-vakansiya-3", LastMod: "2026-10-03T13:00:00Z"}, set.URLs[0])
+		assert.Equal(t, "https://example.com/vacancy/testovaya-vakansiya-1", set.URLs[2].Loc)
+
+		// THEN: Страницы прошлой выгрузки удалены
+		assert.NoFileExists(t, filepath.Join(dir, "index.html"))
+		assert.NoDirExists(t, filepath.Join(dir, "jobs"))
+		assert.NoDirExists(t, filepath.Join(dir, "technologies"))
+	})
+
+	t.Run("индекс sitemap при превышении лимита", func(t *testing.T) {
+		// GIVEN: Лимит две ссылки на файл и лишняя часть от прошлой выгрузки
+		exporter := newTestExporter(t, false)
+		exporter.maxURLs = 2
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "sitemap-9.xml"), []byte("old"), 0644))
+
+		// WHEN: Выгружаем
+		report, err := exporter.Export(ctx, dir)
+
+		// THEN: Три ссылки разложены на две части, sitemap.xml - их индекс
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Sitemaps)
+
+		data, err := os.ReadFile(filepath.Join(dir, "sitemap.xml"))
+		require.NoError(t, err)
+		var index sitemapIndex
+		require.NoError(t, xml.Unmarshal(data, &index))
+		require.Len(t, index.Sitemaps, 2)
+		assert.Equal(t, "https://example.com/sitemap-1.xml", index.Sitemaps[0].Loc)
+		assert.Equal(t, "2026-10-03T13:00:00Z", index.Sitemaps[0].LastMod)
+
+		assert.Len(t, readURLSet(t, filepath.Join(dir, "sitemap-2.xml")).URLs, 1)
+		assert.NoFileExists(t, filepath.Join(dir, "sitemap-9.xml"))
+
+		// WHEN: Выгружаем снова без лимита
+		exporter.maxURLs = sitemapMaxURLs
+		_, err = exporter.Export(ctx, dir)
+
+		// THEN: Части удалены, sitemap.xml снова содержит ссылки
+		require.NoError(t, err)
+		assert.NoFileExists(t, filepath.Join(dir, "sitemap-1.xml"))
+		assert.Len(t, readURLSet(t, filepath.Join(dir, "sitemap.xml")).URLs, 3)
+	})
+
+	t.Run("статический сайт", func(t *testing.T) {
+		// GIVEN: Выгрузка с HTML и страница вакансии от прошлой выгрузки
+		exporter := newTestExporter(t, true)
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "jobs", "old-job"), 0755))
+
+		// WHEN: Выгружаем
+		report, err := exporter.Export(ctx, dir)
+
+		// THEN: Записаны главная, две страницы технологий и три страницы вакансий
+		require.NoError(t, err)
+		assert.Equal(t, 6, report.Pages)
+		assert.NoDirExists(t, filepath.Join(dir, "jobs", "old-job"))
+
+		// THEN: В sitemap ровно записанные страницы
+		assert.Equal(t, 6, report.URLs)
+		set := readURLSet(t, filepath.Join(dir, "sitemap.xml"))
+		require.Len(t, set.URLs, 6)
+		assert.Equal(t, sitemapEntry{Loc: "https://example.com/", LastMod: "2026-10-03T13:00:00Z"}, set.URLs[0])
+		assert.Equal(t, "https://example.com/technologies/golang/", set.URLs[1].Loc)
+		assert.Equal(t, "https://example.com/technologies/c-plus-plus/", set.URLs[2].Loc)
+		assert.Equal(t, "https://example.com/jobs/testovaya-vakansiya-3/", set.URLs[3].Loc)
+
+		index, err := os.ReadFile(filepath.Join(dir, "index.html"))
+		require.NoError(t, err)
+		assert.Contains(t, string(index), `<a href="https://example.com/technologies/c-plus-plus/">C&#43;&#43;</a>`)
+		assert.Contains(t, string(index), "https://example.com/jobs/testovaya-vakansiya-2/")
+
+		technology, err := os.ReadFile(filepath.Join(dir, "technologies", "golang", "index.html"))
+		require.NoError(t, err)
+		assert.Contains(t, string(technology), "testovaya-vakansiya-3")
+		assert.NotContains(t, string(technology), "testovaya-vakansiya-2")
+
+		job, err := os.ReadFile(filepath.Join(dir, "jobs", "testovaya-vakansiya-1", "index.html"))
+		require.NoError(t, err)
+		assert.Contains(t, string(job), `<link rel="canonical" href="https://example.com/jobs/testovaya-vakansiya-1/">`)
+		assert.Contains(t, string(job), "01.10.2026")
+		assert.Contains(t, string(job), `href="https://t.me/test_channel/1"`)
+	})
+
+	t.Run("текст вакансии экранируется", func(t *testing.T) {
+		// GIVEN: Вакансия с разметкой в тексте
+		repo := newTestRepository(t)
+		repo.Jobs[0].ContentPure = "<script>alert(1)</script>"
+		config := DefaultConfig()
+		config.BaseURL = "https://example.com"
+		config.HTML = true
+		exporter, err := NewExporter(repo, config)
+		require.NoError(t, err)
+		dir := t.TempDir()
+
+		// WHEN: Выгружаем
+		_, err = exporter.Export(ctx, dir)
+
+		// THEN: Разметка выведена текстом
+		require.NoError(t, err)
+		job, err := os.ReadFile(filepath.Join(dir, "jobs", "testovaya-vakansiya-1", "index.html"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(job), "<script>")
+		assert.Contains(t, string(job), "&lt;script&gt;")
+	})
+
+	t.Run("свои шаблоны", func(t *testing.T) {
+		// GIVEN: Каталог со своим шаблоном страницы вакансии
+		templates := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(templates, "job.html"), []byte(`{{define "job.html"}}custom {{.Job.Slug}}{{end}}`), 0644))
+
+		repo := newTestRepository(t)
+		config := DefaultConfig()
+		config.BaseURL = "https://example.com"
+		config.HTML = true
+		config.Templates = templates
+		exporter, err := NewExporter(repo, config)
+		require.NoError(t, err)
+		dir := t.TempDir()
+
+		// WHEN: Выгружаем
+		_, err = exporter.Export(ctx, dir)
+
+		// THEN: Страница вакансии собрана своим шаблоном, остальные - встроенными
+		require.NoError(t, err)
+		job, err := os.ReadFile(filepath.Join(dir, "jobs", "testovaya-vakansiya-1", "index.html"))
+		require.NoError(t, err)
+		assert.Equal(t, "custom testovaya-vakansiya-1", string(job))
+		assert.FileExists(t, filepath.Join(dir, "index.html"))
+	})
+
+	t.Run("ошибка репозитория", func(t *testing.T) {
+		// GIVEN: Репозиторий возвращает ошибки
+		repo := newTestRepository(t)
+		repo.ShouldError = true
+		config := DefaultConfig()
+		config.BaseURL = "https://example.com"
+		config.HTML = true
+		exporter, err := NewExporter(repo, config)
+		require.NoError(t, err)
+
+		// WHEN: Выгружаем
+		_, err = exporter.Export(ctx, t.TempDir())
+
+		// THEN: Ошибка возвращается
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,131 @@
+package site
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zalhonan/remotejobs-web-scraper/internal/utils"
+)
+
+const (
+	sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	// sitemapMaxURLs - ограничение протокола sitemap на число ссылок в одном файле
+	sitemapMaxURLs = 50000
+	// sitemapFile - главный файл sitemap: список ссылок или индекс частей sitemap-<n>.xml
+	sitemapFile = "sitemap.xml"
+)
+
+type sitemapURL struct {
+	Loc     string    `xml:"loc"`
+	LastMod time.Time `xml:"-"`
+}
+
+type urlSet struct {
+	XMLName xml.Name       `xml:"urlset"`
+	XMLNS   string         `xml:"xmlns,attr"`
+	URLs    []sitemapEntry `xml:"url"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name       `xml:"sitemapindex"`
+	XMLNS    string         `xml:"xmlns,attr"`
+	Sitemaps []sitemapEntry `xml:"sitemap"`
+}
+
+type sitemapEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+func newSitemapEntry(loc string, lastMod time.Time) sitemapEntry {
+	entry := sitemapEntry{Loc: loc}
+	if !lastMod.IsZero() {
+		entry.LastMod = lastMod.UTC().Format(time.RFC3339)
+	}
+	return entry
+}
+
+// writeSitemaps записывает sitemap.xml в каталог dir. Если ссылок больше maxURLs, они делятся на
+// файлы sitemap-<n>.xml, а sitemap.xml становится их индексом. Части от прошлых выгрузок,
+// которые больше не нужны, удаляются. Возвращает число файлов со ссылками
+func (e *Exporter) writeSitemaps(dir string, urls []sitemapURL) (int, error) {
+	var parts [][]sitemapURL
+	for start := 0; start < len(urls); start += e.maxURLs {
+		parts = append(parts, urls[start:min(start+e.maxURLs, len(urls))])
+	}
+
+	written := make(map[string]bool)
+
+	if len(parts) <= 1 {
+		if err := writeXML(filepath.Join(dir, sitemapFile), newURLSet(urls)); err != nil {
+			return 0, err
+		}
+	} else {
+		index := sitemapIndex{XMLNS: sitemapNamespace}
+		for i, part := range parts {
+			name := fmt.Sprintf("sitemap-%d.xml", i+1)
+			if err := writeXML(filepath.Join(dir, name), newURLSet(part)); err != nil {
+				return 0, err
+			}
+			written[name] = true
+
+			index.Sitemaps = append(index.Sitemaps, newSitemapEntry(e.homeURL()+name, lastModified(part)))
+		}
+
+		if err := writeXML(filepath.Join(dir, sitemapFile), index); err != nil {
+			return 0, err
+		}
+	}
+
+	stale, err := filepath.Glob(filepath.Join(dir, "sitemap-*.xml"))
+	if err != nil {
+		return 0, err
+	}
+	for _, path := range stale {
+		if !written[filepath.Base(path)] {
+			if err := os.Remove(path); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return max(len(parts), 1), nil
+}
+
+// lastModified возвращает самое позднее время изменения ссылок
+func lastModified(urls []sitemapURL) time.Time {
+	var last time.Time
+	for _, u := range urls {
+		if u.LastMod.After(last) {
+			last = u.LastMod
+		}
+	}
+	return last
+}
+
+func newURLSet(urls []sitemapURL) urlSet {
+	set := urlSet{XMLNS: sitemapNamespace}
+	for _, u := range urls {
+		set.URLs = append(set.URLs, newSitemapEntry(u.Loc, u.LastMod))
+	}
+	return set
+}
+
+// writeXML атомарно записывает XML-документ в файл path
+func writeXML(path string, document any) error {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	encoder := xml.NewEncoder(&buf)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+	buf.WriteString("\n")
+
+	return utils.WriteFileAtomic(path, buf.Bytes())
+}
@@ -0,0 +1,49 @@
+package site
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"time"
+)
+
+//go:embed templates/*.html
+var defaultTemplates embed.FS
+
+// Имена шаблонов страниц
+const (
+	indexTemplate      = "index.html"
+	technologyTemplate = "technology.html"
+	jobTemplate        = "job.html"
+)
+
+// parseTemplates разбирает встроенные шаблоны и накладывает на них шаблоны из каталога dir
+func parseTemplates(dir string) (*template.Template, error) {
+	templates, err := template.New("").Funcs(template.FuncMap{
+		"date": func(t time.Time) string {
+			return t.Format("02.01.2006")
+		},
+	}).ParseFS(defaultTemplates, "templates/*.html")
+	if err != nil {
+		return nil, fmt.Errorf("встроенные шаблоны: %w", err)
+	}
+
+	if dir == "" {
+		return templates, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.html"))
+	if err != nil {
+		return nil, fmt.Errorf("шаблоны %s: %w", dir, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("шаблоны %s: нет файлов *.html", dir)
+	}
+
+	if templates, err = templates.ParseFiles(files...); err != nil {
+		return nil, fmt.Errorf("шаблоны %s: %w", dir, err)
+	}
+
+	return templates, nil
+}
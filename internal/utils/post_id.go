@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PostIDFromLink возвращает ID поста из ссылки вида https://t.me/<tag>/<id>
+// или из атрибута data-post вида <tag>/<id>
+func PostIDFromLink(link string) (int64, error) {
+	idx := strings.LastIndex(link, "/")
+	if idx == -1 || idx == len(link)-1 {
+		return 0, fmt.Errorf("в ссылке %q нет ID поста", link)
+	}
+
+	postID, err := strconv.ParseInt(link[idx+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("в ссылке %q нет ID поста: %w", link, err)
+	}
+
+	return postID, nil
+}
@@ -0,0 +1,30 @@
+package utils
+
+import "testing"
+
+func TestPostIDFromLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     string
+		expected int64
+		ok       bool
+	}{
+		{name: "Ссылка на пост", link: "https://t.me/java_rabota/1234", expected: 1234, ok: true},
+		{name: "Атрибут data-post", link: "java_rabota/77", expected: 77, ok: true},
+		{name: "Пустая ссылка", link: "", ok: false},
+		{name: "Ссылка без ID", link: "https://t.me/java_rabota/", ok: false},
+		{name: "Нечисловой ID", link: "https://t.me/java_rabota/abc", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			postID, err := PostIDFromLink(test.link)
+			if (err == nil) != test.ok {
+				t.Errorf("Ожидалась ошибка: %v, получено: %v", !test.ok, err)
+			}
+			if postID != test.expected {
+				t.Errorf("Ожидалось: %d, получено: %d", test.expected, postID)
+			}
+		})
+	}
+}
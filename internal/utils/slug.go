@@ -3,12 +3,13 @@ package utils
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
 var nonAlphanumericRegex = regexp.MustCompile(`[^a-zA-Z0-9\s-]`)
 var multipleSpacesRegex = regexp.MustCompile(`\s+`)
-var genericSlugRegex = regexp.MustCompile(`^\d+-(?:vakansiya|vacancy)$`)
+var genericSlugRegex = regexp.MustCompile(`^(?:vakansiya|vacancy)$`)
 
 // Таблица транслитерации кириллических символов
 var translitMap = map[rune]string{
@@ -43,14 +44,20 @@ func transliterate(input string) string {
 // GenerateSlug создает слаг в формате <id>-<title> или <id>-<main_technology>, если title пустой
 // Преобразует заголовок в нижний регистр, удаляет специальные символы и заменяет пробелы на дефисы
 func GenerateSlug(id int64, title string, mainTechnology string) string {
+	return strconv.FormatInt(id, 10) + SlugSuffix(title, mainTechnology)
+}
+
+// SlugSuffix возвращает часть слага после ID: "-<title>", "-<main_technology>" или пустую строку.
+// Не зависит от ID, поэтому слаг можно собрать в SQL в том же запросе, который выдает ID вакансии
+func SlugSuffix(title string, mainTechnology string) string {
 	// Если заголовок пустой, но есть основная технология, используем её
 	if title == "" {
 		if mainTechnology != "" {
-			// Формируем слаг из ID и основной технологии
-			return fmt.Sprintf("%d-%s", id, strings.ToLower(mainTechnology))
+			// Формируем суффикс из основной технологии
+			return "-" + strings.ToLower(mainTechnology)
 		}
-		// Если и заголовок, и основная технология пусты, используем только ID
-		return fmt.Sprintf("%d", id)
+		// Если и заголовок, и основная технология пусты, слаг состоит только из ID
+		return ""
 	}
 
 	// Транслитерируем кириллические символы в латинские
@@ -78,14 +85,18 @@ func GenerateSlug(id int64, title string, mainTechnology string) string {
 		slug = strings.TrimSuffix(slug, "-")
 	}
 
-	// Формируем финальный слаг в формате <id>-<slug>
-	result := fmt.Sprintf("%d-%s", id, slug)
-
-	// Проверяем, если слаг имеет формат <id>-vakansiya или <id>-vacancy
-	// и есть основная технология, добавляем её
-	if genericSlugRegex.MatchString(result) && mainTechnology != "" {
-		result = fmt.Sprintf("%s-%s", result, strings.ToLower(mainTechnology))
+	// Если заголовок сводится к "vakansiya" или "vacancy" и есть основная технология, добавляем её
+	if genericSlugRegex.MatchString(slug) && mainTechnology != "" {
+		slug = fmt.Sprintf("%s-%s", slug, strings.ToLower(mainTechnology))
 	}
 
-	return result
+	return "-" + slug
+}
+
+// technologySymbols - символы в названиях технологий, которые иначе потерялись бы в слаге ("c++", "c#", ".net")
+var technologySymbols = strings.NewReplacer("+", " plus ", "#", " sharp ", ".", " dot ")
+
+// TechnologySlug возвращает слаг технологии для путей и имен файлов, например "c++" -> "c-plus-plus"
+func TechnologySlug(technology string) string {
+	return strings.TrimPrefix(SlugSuffix(technologySymbols.Replace(technology), ""), "-")
 }
@@ -145,3 +145,44 @@ func TestGenerateSlug(t *testing.T) {
 		})
 	}
 }
+
+func TestSlugSuffix(t *testing.T) {
+	tests := []struct {
+		title          string
+		mainTechnology string
+	}{
+		{"Golang разработчик", "golang"},
+		{"Вакансия", "java"},
+		{"", "python"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title+"/"+tt.mainTechnology, func(t *testing.T) {
+			// Слаг всегда складывается из ID и суффикса
+			expected := GenerateSlug(42, tt.title, tt.mainTechnology)
+			if got := "42" + SlugSuffix(tt.title, tt.mainTechnology); got != expected {
+				t.Errorf("Ожидалось: %s, получено: %s", expected, got)
+			}
+		})
+	}
+}
+
+func TestTechnologySlug(t *testing.T) {
+	tests := map[string]string{
+		"golang":  "golang",
+		"C++":     "c-plus-plus",
+		"c#":      "c-sharp",
+		".NET":    "dot-net",
+		"Node.js": "node-dot-js",
+		"1С":      "1s",
+	}
+
+	for technology, expected := range tests {
+		t.Run(technology, func(t *testing.T) {
+			if got := TechnologySlug(technology); got != expected {
+				t.Errorf("Ожидалось: %s, получено: %s", expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a", "b", "file.txt")
+
+	for _, content := range []string{"первая версия", "вторая"} {
+		if err := WriteFileAtomic(path, []byte(content)); err != nil {
+			t.Fatalf("Ошибка записи: %v", err)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Ошибка чтения: %v", err)
+		}
+		if string(data) != content {
+			t.Errorf("Ожидалось: %s, получено: %s", content, data)
+		}
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatalf("Ошибка чтения каталога: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("Ожидался один файл без временных, получено: %d", len(entries))
+	}
+}
+
+func TestRemoveStaleDirs(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"keep", "stale"} {
+		if err := os.MkdirAll(filepath.Join(dir, name), 0755); err != nil {
+			t.Fatalf("Ошибка создания каталога: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "file.txt"), nil, 0644); err != nil {
+		t.Fatalf("Ошибка записи: %v", err)
+	}
+
+	if err := RemoveStaleDirs(dir, map[string]bool{"keep": true}); err != nil {
+		t.Fatalf("Ошибка удаления: %v", err)
+	}
+
+	for name, exists := range map[string]bool{"keep": true, "stale": false, "file.txt": true} {
+		if _, err := os.Stat(filepath.Join(dir, name)); (err == nil) != exists {
+			t.Errorf("%s: ожидалось наличие %v, получено: %v", name, exists, err)
+		}
+	}
+
+	if err := RemoveStaleDirs(filepath.Join(dir, "missing"), nil); err != nil {
+		t.Errorf("Отсутствующий каталог не должен быть ошибкой: %v", err)
+	}
+}
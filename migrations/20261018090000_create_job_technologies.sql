@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS job_technologies (
+    job_id BIGINT NOT NULL REFERENCES jobs_raw(id) ON DELETE CASCADE,
+    technology_id BIGINT NOT NULL REFERENCES technologies(id) ON DELETE CASCADE,
+    score INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (job_id, technology_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_technologies_technology_id ON job_technologies(technology_id);
+
+-- Переносим уже определенные основные технологии; точные оценки пересчитывает команда reclassify
+INSERT INTO job_technologies (job_id, technology_id, score)
+SELECT j.id, t.id, 1
+FROM jobs_raw j
+JOIN technologies t ON t.technology = j.main_technology
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS job_technologies;
+-- +goose StatementEnd
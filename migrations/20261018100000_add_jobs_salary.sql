@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs_raw
+    ADD COLUMN IF NOT EXISTS salary_min BIGINT,
+    ADD COLUMN IF NOT EXISTS salary_max BIGINT,
+    ADD COLUMN IF NOT EXISTS salary_currency VARCHAR(3),
+    ADD COLUMN IF NOT EXISTS salary_period VARCHAR(16),
+    ADD COLUMN IF NOT EXISTS salary_tax VARCHAR(8),
+    ADD COLUMN IF NOT EXISTS salary_min_base BIGINT,
+    ADD COLUMN IF NOT EXISTS salary_max_base BIGINT;
+
+-- Приведенные к базовой валюте суммы используются для фильтрации и сортировки по зарплате
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_salary_min_base ON jobs_raw(salary_min_base);
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_salary_max_base ON jobs_raw(salary_max_base);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_raw_salary_max_base;
+DROP INDEX IF EXISTS idx_jobs_raw_salary_min_base;
+
+ALTER TABLE jobs_raw
+    DROP COLUMN IF EXISTS salary_max_base,
+    DROP COLUMN IF EXISTS salary_min_base,
+    DROP COLUMN IF EXISTS salary_tax,
+    DROP COLUMN IF EXISTS salary_period,
+    DROP COLUMN IF EXISTS salary_currency,
+    DROP COLUMN IF EXISTS salary_max,
+    DROP COLUMN IF EXISTS salary_min;
+-- +goose StatementEnd
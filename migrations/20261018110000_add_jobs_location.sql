@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs_raw
+    ADD COLUMN IF NOT EXISTS work_format VARCHAR(16),
+    ADD COLUMN IF NOT EXISTS relocation BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS countries TEXT[],
+    ADD COLUMN IF NOT EXISTS cities TEXT[],
+    ADD COLUMN IF NOT EXISTS timezone_min INTEGER,
+    ADD COLUMN IF NOT EXISTS timezone_max INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_work_format ON jobs_raw(work_format);
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_countries ON jobs_raw USING GIN (countries);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_raw_countries;
+DROP INDEX IF EXISTS idx_jobs_raw_work_format;
+
+ALTER TABLE jobs_raw
+    DROP COLUMN IF EXISTS timezone_max,
+    DROP COLUMN IF EXISTS timezone_min,
+    DROP COLUMN IF EXISTS cities,
+    DROP COLUMN IF EXISTS countries,
+    DROP COLUMN IF EXISTS relocation,
+    DROP COLUMN IF EXISTS work_format;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs_raw
+    ADD COLUMN IF NOT EXISTS seniority TEXT[],
+    ADD COLUMN IF NOT EXISTS employment_type TEXT[];
+
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_seniority ON jobs_raw USING GIN (seniority);
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_employment_type ON jobs_raw USING GIN (employment_type);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_raw_employment_type;
+DROP INDEX IF EXISTS idx_jobs_raw_seniority;
+
+ALTER TABLE jobs_raw
+    DROP COLUMN IF EXISTS employment_type,
+    DROP COLUMN IF EXISTS seniority;
+-- +goose StatementEnd
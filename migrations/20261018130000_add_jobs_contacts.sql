@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs_raw
+    ADD COLUMN IF NOT EXISTS company VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS telegram_contacts TEXT[],
+    ADD COLUMN IF NOT EXISTS emails TEXT[],
+    ADD COLUMN IF NOT EXISTS phones TEXT[],
+    ADD COLUMN IF NOT EXISTS apply_urls TEXT[];
+
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_company ON jobs_raw(company);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_raw_company;
+
+ALTER TABLE jobs_raw
+    DROP COLUMN IF EXISTS apply_urls,
+    DROP COLUMN IF EXISTS phones,
+    DROP COLUMN IF EXISTS emails,
+    DROP COLUMN IF EXISTS telegram_contacts,
+    DROP COLUMN IF EXISTS company;
+-- +goose StatementEnd
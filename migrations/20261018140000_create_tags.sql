@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS tags (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS job_tags (
+    job_id BIGINT NOT NULL REFERENCES jobs_raw(id) ON DELETE CASCADE,
+    tag_id BIGINT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (job_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_tags_tag_id ON job_tags(tag_id);
+
+-- Хэштеги, которые считаются упоминанием технологии при классификации
+CREATE TABLE IF NOT EXISTS tag_aliases (
+    tag VARCHAR(255) PRIMARY KEY,
+    technology_id BIGINT NOT NULL REFERENCES technologies(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_tag_aliases_technology_id ON tag_aliases(technology_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tag_aliases;
+DROP TABLE IF EXISTS job_tags;
+DROP TABLE IF EXISTS tags;
+-- +goose StatementEnd
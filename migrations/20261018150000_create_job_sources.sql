@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs_raw ADD COLUMN IF NOT EXISTS simhash BIGINT;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_date_posted ON jobs_raw(date_posted);
+
+-- Все посты, в которых опубликована вакансия: оригинал и репосты в других каналах
+CREATE TABLE IF NOT EXISTS job_sources (
+    job_id BIGINT NOT NULL REFERENCES jobs_raw(id) ON DELETE CASCADE,
+    source_link VARCHAR(2048) NOT NULL UNIQUE,
+    date_posted TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    date_added TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_sources_job_id ON job_sources(job_id);
+
+INSERT INTO job_sources (job_id, source_link, date_posted, date_added)
+SELECT id, source_link, date_posted, date_parsed
+FROM jobs_raw
+ON CONFLICT (source_link) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS job_sources;
+DROP INDEX IF EXISTS idx_jobs_raw_date_posted;
+ALTER TABLE jobs_raw DROP COLUMN IF EXISTS simhash;
+-- +goose StatementEnd
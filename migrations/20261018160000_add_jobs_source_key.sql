@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs_raw
+    ADD COLUMN IF NOT EXISTS source VARCHAR(50) NOT NULL DEFAULT 'telegram',
+    ADD COLUMN IF NOT EXISTS channel VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS post_id BIGINT;
+
+-- Канал и ID поста из ссылок вида https://t.me/<tag>/<id>
+UPDATE jobs_raw
+SET channel = substring(source_link FROM '^https?://t\.me/(?:s/)?([A-Za-z0-9_]+)/\d+'),
+    post_id = substring(source_link FROM '^https?://t\.me/(?:s/)?[A-Za-z0-9_]+/(\d+)')::BIGINT
+WHERE channel IS NULL;
+
+-- Повторно сохраненные посты: оставляем первую запись, источники повторов переносим на нее
+UPDATE job_sources s
+SET job_id = kept.id
+FROM jobs_raw a
+JOIN LATERAL (
+    SELECT MIN(c.id) AS id
+    FROM jobs_raw c
+    WHERE c.source = a.source AND c.channel = a.channel AND c.post_id = a.post_id
+) kept ON kept.id < a.id
+WHERE s.job_id = a.id;
+
+DELETE FROM jobs_raw a
+USING jobs_raw b
+WHERE a.source = b.source
+  AND a.channel = b.channel
+  AND a.post_id = b.post_id
+  AND a.id > b.id;
+
+ALTER TABLE jobs_raw
+    ADD CONSTRAINT jobs_raw_source_channel_post_id_key UNIQUE (source, channel, post_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE jobs_raw DROP CONSTRAINT IF EXISTS jobs_raw_source_channel_post_id_key;
+
+ALTER TABLE jobs_raw
+    DROP COLUMN IF EXISTS post_id,
+    DROP COLUMN IF EXISTS channel,
+    DROP COLUMN IF EXISTS source;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs_raw
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active',
+    ADD COLUMN IF NOT EXISTS date_edited TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS date_closed TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_status ON jobs_raw(status);
+
+-- Предыдущие версии текста вакансии; date_revised - когда версия была заменена правкой поста
+CREATE TABLE IF NOT EXISTS job_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    job_id BIGINT NOT NULL REFERENCES jobs_raw(id) ON DELETE CASCADE,
+    title TEXT,
+    content TEXT NOT NULL,
+    content_pure TEXT,
+    date_revised TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_revisions_job_id ON job_revisions(job_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS job_revisions;
+DROP INDEX IF EXISTS idx_jobs_raw_status;
+
+ALTER TABLE jobs_raw
+    DROP COLUMN IF EXISTS date_closed,
+    DROP COLUMN IF EXISTS date_edited,
+    DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
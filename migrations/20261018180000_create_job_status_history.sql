@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs_raw
+    ADD CONSTRAINT jobs_raw_status_check
+    CHECK (status IN ('active', 'closed', 'deleted', 'expired', 'hidden', 'spam'));
+
+-- История смены статусов вакансий; old_status пуст у статуса, с которым вакансия была сохранена
+CREATE TABLE IF NOT EXISTS job_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    job_id BIGINT NOT NULL REFERENCES jobs_raw(id) ON DELETE CASCADE,
+    old_status VARCHAR(20),
+    new_status VARCHAR(20) NOT NULL,
+    date_changed TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_status_history_job_id ON job_status_history(job_id);
+
+-- Начальный статус уже сохраненных вакансий
+INSERT INTO job_status_history (job_id, old_status, new_status, date_changed)
+SELECT id, NULL, status, date_parsed
+FROM jobs_raw;
+
+-- Переходы записываются триггером, чтобы история не зависела от того, какой запрос меняет статус
+CREATE OR REPLACE FUNCTION record_job_status_change() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO job_status_history (job_id, old_status, new_status)
+        VALUES (NEW.id, NULL, NEW.status);
+    ELSIF OLD.status IS DISTINCT FROM NEW.status THEN
+        INSERT INTO job_status_history (job_id, old_status, new_status)
+        VALUES (NEW.id, OLD.status, NEW.status);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_raw_status_history
+    AFTER INSERT OR UPDATE OF status ON jobs_raw
+    FOR EACH ROW EXECUTE FUNCTION record_job_status_change();
+
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_status_date_posted ON jobs_raw(status, date_posted);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_raw_status_date_posted;
+DROP TRIGGER IF EXISTS jobs_raw_status_history ON jobs_raw;
+DROP FUNCTION IF EXISTS record_job_status_change();
+DROP TABLE IF EXISTS job_status_history;
+
+ALTER TABLE jobs_raw DROP CONSTRAINT IF EXISTS jobs_raw_status_check;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Запуски парсеров: один запуск - один парсер
+CREATE TABLE IF NOT EXISTS scrape_runs (
+    id BIGSERIAL PRIMARY KEY,
+    parser VARCHAR(100) NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    duration_ms BIGINT NOT NULL,
+    posts_seen INT NOT NULL DEFAULT 0,
+    posts_new INT NOT NULL DEFAULT 0,
+    posts_updated INT NOT NULL DEFAULT 0,
+    posts_duplicate INT NOT NULL DEFAULT 0,
+    posts_dropped INT NOT NULL DEFAULT 0,
+    posts_stop_words INT NOT NULL DEFAULT 0,
+    channels_total INT NOT NULL DEFAULT 0,
+    channels_failed INT NOT NULL DEFAULT 0,
+    error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_scrape_runs_started_at ON scrape_runs(started_at);
+
+-- Результаты обхода каналов в рамках запуска
+CREATE TABLE IF NOT EXISTS scrape_run_channels (
+    id BIGSERIAL PRIMARY KEY,
+    run_id BIGINT NOT NULL REFERENCES scrape_runs(id) ON DELETE CASCADE,
+    channel VARCHAR(255) NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    duration_ms BIGINT NOT NULL,
+    http_status INT,
+    posts_seen INT NOT NULL DEFAULT 0,
+    posts_new INT NOT NULL DEFAULT 0,
+    posts_updated INT NOT NULL DEFAULT 0,
+    posts_duplicate INT NOT NULL DEFAULT 0,
+    posts_dropped INT NOT NULL DEFAULT 0,
+    posts_stop_words INT NOT NULL DEFAULT 0,
+    error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_scrape_run_channels_run_id ON scrape_run_channels(run_id);
+CREATE INDEX IF NOT EXISTS idx_scrape_run_channels_channel ON scrape_run_channels(channel, started_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS scrape_run_channels;
+DROP TABLE IF EXISTS scrape_runs;
+-- +goose StatementEnd
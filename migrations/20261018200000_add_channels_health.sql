@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- enabled = FALSE исключает канал из сбора; health_issues - проблемы, найденные последней проверкой здоровья канала.
+-- date_enabled - когда канал был включен вручную: ошибки и простой до этого момента не учитываются
+ALTER TABLE telegram_channels
+    ADD COLUMN IF NOT EXISTS enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN IF NOT EXISTS health_issues TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS disabled_reason TEXT,
+    ADD COLUMN IF NOT EXISTS date_disabled TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS date_enabled TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_channel_date_posted ON jobs_raw(channel, date_posted);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_raw_channel_date_posted;
+
+ALTER TABLE telegram_channels
+    DROP COLUMN IF EXISTS date_enabled,
+    DROP COLUMN IF EXISTS date_disabled,
+    DROP COLUMN IF EXISTS disabled_reason,
+    DROP COLUMN IF EXISTS health_issues,
+    DROP COLUMN IF EXISTS enabled;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Полнотекстовый поиск по заголовку и тексту вакансии в русской и английской конфигурациях.
+-- Совпадения в заголовке (вес A) ранжируются выше совпадений в тексте (вес B)
+ALTER TABLE jobs_raw
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', COALESCE(title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(title, '')), 'A') ||
+        setweight(to_tsvector('russian', COALESCE(content_pure, '')), 'B') ||
+        setweight(to_tsvector('english', COALESCE(content_pure, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_raw_search_vector ON jobs_raw USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_raw_search_vector;
+
+ALTER TABLE jobs_raw DROP COLUMN IF EXISTS search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- interrupted - обход канала прерван не по вине канала: источник ограничил частоту запросов
+-- или запуск был отменен. Такие ошибки не считаются сбоями канала при проверке здоровья
+ALTER TABLE scrape_run_channels
+    ADD COLUMN IF NOT EXISTS interrupted BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE scrape_run_channels
+    DROP COLUMN IF EXISTS interrupted;
+-- +goose StatementEnd
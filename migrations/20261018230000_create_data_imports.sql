@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Загрузки файлов data/ в БД. По ним команда all узнает, что исходные данные уже загружены,
+-- даже если администратор потом удалил все каналы, технологии или стоп-слова
+CREATE TABLE IF NOT EXISTS data_imports (
+    id BIGSERIAL PRIMARY KEY,
+    imported_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- БД с уже загруженными данными считаются заполненными
+INSERT INTO data_imports (imported_at)
+SELECT NOW()
+WHERE EXISTS (SELECT 1 FROM telegram_channels)
+   OR EXISTS (SELECT 1 FROM technologies)
+   OR EXISTS (SELECT 1 FROM stop_words);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS data_imports;
+-- +goose StatementEnd
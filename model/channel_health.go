@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+// Проблемы, найденные проверкой здоровья канала
+const (
+	// ChannelIssueFailing - несколько обходов канала подряд завершились ошибкой
+	ChannelIssueFailing = "failing"
+	// ChannelIssueInactive - в канале давно не появлялось новых вакансий
+	ChannelIssueInactive = "inactive"
+	// ChannelIssueStopWords - большая доля постов содержит стоп-слова, например канал стал рекламным
+	ChannelIssueStopWords = "stop_words"
+	// ChannelIssueUnclassified - у большой доли постов не определена ни одна технология
+	ChannelIssueUnclassified = "unclassified"
+)
+
+// ChannelIssues - все проблемы, которые находит проверка здоровья канала
+var ChannelIssues = []string{ChannelIssueFailing, ChannelIssueInactive, ChannelIssueStopWords, ChannelIssueUnclassified}
+
+// ChannelHealth - показатели здоровья Telegram-канала
+type ChannelHealth struct {
+	Tag            string
+	Enabled        bool
+	DisabledReason string
+	// ConsecutiveFailures - сколько последних обходов канала подряд завершились ошибкой
+	ConsecutiveFailures int
+	// LastPosted - дата публикации последней сохраненной вакансии канала
+	LastPosted *time.Time
+	// LastActive - начало отсчета простоя: последняя вакансия, добавление или ручное включение канала
+	LastActive time.Time
+	// RecentPosts - количество вакансий канала за период проверки
+	RecentPosts int
+	// StopWordsShare - доля вакансий за период со стоп-словами
+	StopWordsShare float64
+	// UnclassifiedShare - доля вакансий за период без стоп-слов и без определенной технологии
+	UnclassifiedShare float64
+	// Issues - найденные проблемы (ChannelIssue*)
+	Issues []string
+}
+
+// DaysSinceLastPost возвращает количество полных дней с последней сохраненной вакансии канала
+// или -1, если вакансий из канала нет
+func (h ChannelHealth) DaysSinceLastPost(now time.Time) int {
+	if h.LastPosted == nil {
+		return -1
+	}
+	return int(now.Sub(*h.LastPosted).Hours() / 24)
+}
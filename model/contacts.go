@@ -0,0 +1,14 @@
+package model
+
+// Contacts - работодатель и способы связи, извлеченные из вакансии
+type Contacts struct {
+	// Company - название компании, пустая строка - не найдено
+	Company string
+	// Telegram - имена пользователей Telegram без "@"
+	Telegram []string
+	Emails   []string
+	// Phones - телефоны в формате +<цифры>
+	Phones []string
+	// ApplyURLs - внешние ссылки (отклик, сайт компании), кроме ссылок на Telegram
+	ApplyURLs []string
+}
@@ -0,0 +1,7 @@
+package model
+
+import "errors"
+
+// ErrInvalidInput оборачивает ошибки проверки аргументов, например некорректный тег канала или статус.
+// Такие ошибки вызваны данными пользователя, а не сбоем хранилища
+var ErrInvalidInput = errors.New("ошибка проверки")
@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// JobFilter - условия выборки вакансий; пустые поля не ограничивают выборку
+type JobFilter struct {
+	// Technology - любая из найденных в вакансии технологий, не только основная
+	Technology string
+	// Tag - хэштег без "#"
+	Tag string
+	// Channel - тег Telegram-канала, из которого собрана вакансия
+	Channel string
+	// From и To - границы даты публикации: From включительно, To не включительно
+	From time.Time
+	To   time.Time
+	// Query - полнотекстовый запрос по заголовку и тексту вакансии: слова, "фраза", -исключение, or
+	Query string
+	// Statuses - статусы вакансий; пустой список означает только JobStatusActive
+	Statuses []string
+	// After - курсор: выбираются вакансии, которые идут в выдаче после него
+	After *JobCursor
+	// Limit - размер страницы
+	Limit int
+}
+
+// JobCursor - позиция вакансии в выдаче, отсортированной по дате публикации и ID по убыванию
+type JobCursor struct {
+	DatePosted time.Time
+	ID         int64
+}
+
+// JobsPage - страница вакансий и курсор следующей страницы
+type JobsPage struct {
+	Jobs []JobRaw
+	// Next - курсор следующей страницы, nil если страница последняя
+	Next *JobCursor
+}
@@ -13,4 +13,34 @@ type JobRaw struct {
 	StopWords      []string
 	DatePosted     time.Time
 	DateParsed     time.Time
+	// Technologies - все найденные технологии по убыванию релевантности, первая из них - MainTechnology
+	Technologies []JobTechnology
+	// Salary - зарплатная вилка, nil если в тексте не найдена
+	Salary *Salary
+	// Location - формат работы, страны, города и часовые пояса
+	Location Location
+	// Seniority - найденные грейды (junior, senior и т.п.)
+	Seniority []string
+	// EmploymentType - найденные типы занятости (full_time, contract и т.п.)
+	EmploymentType []string
+	// Contacts - компания и контакты для отклика
+	Contacts Contacts
+	// Tags - хэштеги поста в нижнем регистре без "#"
+	Tags []string
+	// SimHash - отпечаток текста для поиска дубликатов, 0 если текст слишком короткий
+	SimHash uint64
+	// Status - статус вакансии (JobStatus*)
+	Status string
+}
+
+// DisplayTitle возвращает заголовок для показа вакансии; у постов без заголовка - по основной технологии
+func (j JobRaw) DisplayTitle() string {
+	switch {
+	case j.Title != "":
+		return j.Title
+	case j.MainTechnology != "":
+		return "Вакансия: " + j.MainTechnology
+	default:
+		return "Вакансия"
+	}
 }
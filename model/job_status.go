@@ -0,0 +1,26 @@
+package model
+
+// Статусы вакансии
+const (
+	// JobStatusActive - вакансия опубликована и не помечена закрытой
+	JobStatusActive = "active"
+	// JobStatusClosed - в посте появилась пометка о закрытии вакансии или вакансия перестала проходить
+	// фильтр формата работы
+	JobStatusClosed = "closed"
+	// JobStatusDeleted - пост удален из канала
+	JobStatusDeleted = "deleted"
+	// JobStatusExpired - с публикации прошло больше срока актуальности вакансии
+	JobStatusExpired = "expired"
+	// JobStatusHidden - вакансия скрыта вручную
+	JobStatusHidden = "hidden"
+	// JobStatusSpam - пост помечен вручную как спам
+	JobStatusSpam = "spam"
+)
+
+// JobStatuses - все статусы вакансии
+var JobStatuses = []string{
+	JobStatusActive, JobStatusClosed, JobStatusDeleted, JobStatusExpired, JobStatusHidden, JobStatusSpam,
+}
+
+// ModerationStatuses - статусы, которые назначаются только вручную и не меняются при повторном сборе поста
+var ModerationStatuses = []string{JobStatusHidden, JobStatusSpam}
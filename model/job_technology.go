@@ -0,0 +1,8 @@
+package model
+
+// JobTechnology - технология, найденная в вакансии, и ее релевантность
+type JobTechnology struct {
+	TechnologyID int64
+	Technology   string
+	Score        int
+}
@@ -0,0 +1,23 @@
+package model
+
+// Форматы работы
+const (
+	WorkFormatRemote = "remote"
+	WorkFormatHybrid = "hybrid"
+	WorkFormatOffice = "office"
+)
+
+// Location - формат работы и географические ограничения вакансии
+type Location struct {
+	// WorkFormat - WorkFormatRemote, WorkFormatHybrid, WorkFormatOffice или пустая строка, если не указан
+	WorkFormat string
+	// Relocation - компания предлагает релокацию
+	Relocation bool
+	// Countries - упомянутые страны (ISO 3166-1 alpha-2, "EU" для Евросоюза)
+	Countries []string
+	// Cities - упомянутые города
+	Cities []string
+	// TimezoneMin и TimezoneMax - допустимый диапазон часовых поясов в минутах от UTC, nil - не указан
+	TimezoneMin *int
+	TimezoneMax *int
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// ParsedChannel - посты одного канала, собранные за запуск парсера, со сведениями об обходе
+type ParsedChannel struct {
+	Channel    string
+	Jobs       []JobRaw
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// HTTPStatus - статус последнего ответа источника; 0 - ответ не получен
+	HTTPStatus int
+	// Truncated - обход уже известного канала остановлен ограничением глубины, не дойдя до его last_post_id
+	Truncated bool
+	// Err - ошибка обхода; Jobs при этом может содержать частичный результат
+	Err error
+}
+
+// Incomplete сообщает, что посты канала собраны не подряд до last_post_id: обход прерван ошибкой,
+// отменой или ограничением глубины. Такие посты сохраняются, но last_post_id по ним не сдвигается
+func (c ParsedChannel) Incomplete() bool {
+	return c.Err != nil || c.Truncated
+}
@@ -0,0 +1,12 @@
+package model
+
+// RevisitedChannel - посты канала, заново собранные для отслеживания правок и удалений
+type RevisitedChannel struct {
+	Channel string
+	// FromPostID и ToPostID - диапазон просмотренных постов без пропусков страниц.
+	// Сохраненные вакансии из этого диапазона, которых нет в Jobs, удалены из канала.
+	// Нулевой диапазон означает, что обход был неполным и удаления не определяются
+	FromPostID int64
+	ToPostID   int64
+	Jobs       []JobRaw
+}
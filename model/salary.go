@@ -0,0 +1,31 @@
+package model
+
+// Периоды выплаты зарплаты
+const (
+	SalaryPeriodHour  = "hour"
+	SalaryPeriodDay   = "day"
+	SalaryPeriodMonth = "month"
+	SalaryPeriodYear  = "year"
+)
+
+// Налогообложение указанной зарплаты
+const (
+	SalaryTaxGross = "gross"
+	SalaryTaxNet   = "net"
+)
+
+// Salary - зарплатная вилка, извлеченная из текста вакансии
+type Salary struct {
+	// Min и Max - границы вилки в исходной валюте и периоде, 0 - граница не указана
+	Min int64
+	Max int64
+	// Currency - код валюты ISO 4217, пустая строка - валюта не указана
+	Currency string
+	// Period - период выплаты, по умолчанию SalaryPeriodMonth
+	Period string
+	// Tax - SalaryTaxGross, SalaryTaxNet или пустая строка, если не указано
+	Tax string
+	// MinBase и MaxBase - границы вилки в базовой валюте за месяц, 0 - не удалось привести
+	MinBase int64
+	MaxBase int64
+}
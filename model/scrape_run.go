@@ -0,0 +1,74 @@
+package model
+
+import "time"
+
+// PostCounts - счетчики постов, обработанных при сборе вакансий
+type PostCounts struct {
+	// Seen - получено постов
+	Seen int
+	// New - сохранено новых вакансий
+	New int
+	// Updated - обновлено вакансий по измененным постам
+	Updated int
+	// Duplicates - репосты, объединенные с ранее сохраненными вакансиями
+	Duplicates int
+	// Dropped - исключено по формату работы
+	Dropped int
+	// StopWords - отсеяно по стоп-словам: вакансии сохранены, но без технологий
+	StopWords int
+}
+
+// Add прибавляет к счетчикам счетчики other
+func (c *PostCounts) Add(other PostCounts) {
+	c.Seen += other.Seen
+	c.New += other.New
+	c.Updated += other.Updated
+	c.Duplicates += other.Duplicates
+	c.Dropped += other.Dropped
+	c.StopWords += other.StopWords
+}
+
+// SaveReport - результат сохранения вакансий: итоговые счетчики и счетчики по тегам каналов
+type SaveReport struct {
+	PostCounts
+	Channels map[string]PostCounts
+}
+
+// ChannelRun - результат обхода одного канала за запуск сбора
+type ChannelRun struct {
+	Channel    string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	HTTPStatus int
+	PostCounts
+	// Error - ошибка обхода или сохранения; пусто, если канал собран успешно
+	Error string
+	// Interrupted - обход прерван не по вине канала: источник ограничил частоту запросов
+	// или запуск был отменен. Такая ошибка не считается сбоем канала
+	Interrupted bool
+}
+
+// Duration возвращает длительность обхода канала
+func (r ChannelRun) Duration() time.Duration {
+	return r.FinishedAt.Sub(r.StartedAt)
+}
+
+// RunReport - результат запуска одного парсера
+type RunReport struct {
+	// ID - ID записи в scrape_runs; 0, если отчет не сохранен
+	ID         int64
+	Parser     string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	PostCounts
+	// ChannelsFailed - количество каналов с ошибкой
+	ChannelsFailed int
+	// Error - ошибка парсера целиком; ошибки каналов хранятся в Channels
+	Error    string
+	Channels []ChannelRun
+}
+
+// Duration возвращает длительность запуска
+func (r RunReport) Duration() time.Duration {
+	return r.FinishedAt.Sub(r.StartedAt)
+}
@@ -0,0 +1,27 @@
+package model
+
+// Маркеры найденных слов в SearchResult.TitleHighlight и SearchResult.Snippet.
+// Управляющие символы не встречаются в тексте вакансий, поэтому потребитель может сначала
+// экранировать текст, а затем заменить маркеры на свою разметку
+const (
+	HighlightStart = "\x02"
+	HighlightEnd   = "\x03"
+)
+
+// SearchResult - вакансия, найденная полнотекстовым поиском
+type SearchResult struct {
+	Job JobRaw
+	// Rank - релевантность вакансии запросу, чем больше, тем выше в выдаче
+	Rank float64
+	// TitleHighlight - заголовок с найденными словами, выделенными HighlightStart и HighlightEnd
+	TitleHighlight string
+	// Snippet - фрагменты текста вокруг найденных слов с тем же выделением
+	Snippet string
+}
+
+// SearchPage - страница результатов поиска
+type SearchPage struct {
+	Results []SearchResult
+	// Total - количество всех найденных вакансий; 0, если страница за пределами выдачи
+	Total int64
+}
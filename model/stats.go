@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// Stats - сводная статистика по собранным вакансиям и каналам
+// Количество классифицированных вакансий и разбивка по технологиям считаются только по активным вакансиям
+type Stats struct {
+	JobsTotal      int64
+	JobsActive     int64
+	JobsClassified int64
+	JobsLastDay    int64
+	ChannelsTotal  int64
+	LastParsed     *time.Time
+	Statuses       []StatusCount
+	Technologies   []TechnologyCount
+}
+
+// StatusCount - количество вакансий в статусе
+type StatusCount struct {
+	Status string
+	Count  int64
+}
+
+// TechnologyCount - количество вакансий по основной технологии
+type TechnologyCount struct {
+	Technology string
+	Count      int64
+}
@@ -0,0 +1,9 @@
+package model
+
+// TagCount - количество вакансий с хэштегом
+type TagCount struct {
+	Tag string
+	// Technology - технология, с которой связан хэштег, пустая строка если связи нет
+	Technology string
+	Count      int64
+}
@@ -5,4 +5,8 @@ type Technology struct {
 	Technology string
 	Keywords   []string
 	SortOrder  int
+	// Tags - хэштеги, которые считаются упоминанием технологии (tag_aliases)
+	Tags []string
+	// Count - количество активных вакансий с технологией, пересчитывается UpdateTechnologiesCount
+	Count int64
 }